@@ -0,0 +1,40 @@
+package validator
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// maxVarPlans and maxRegexps bound the caches keyed by rule strings
+	// and expressions, which callers may build dynamically, e.g. with
+	// fmt.Sprintf("max=%d", n).
+	maxVarPlans = 1024
+	maxRegexps  = 1024
+)
+
+// boundedCache is a concurrent map emptied once it holds max entries.
+// Its entries are cheap to rebuild, so dropping them all at once is
+// simpler than tracking which ones were used last.
+type boundedCache struct {
+	m    sync.Map
+	size atomic.Int64
+	max  int64
+}
+
+func (c *boundedCache) Load(key any) (any, bool) {
+	return c.m.Load(key)
+}
+
+func (c *boundedCache) LoadOrStore(key, value any) (any, bool) {
+	actual, loaded := c.m.LoadOrStore(key, value)
+	if !loaded && c.size.Add(1) > c.max {
+		c.Clear()
+	}
+	return actual, loaded
+}
+
+func (c *boundedCache) Clear() {
+	c.m.Clear()
+	c.size.Store(0)
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/godev90/validator/faults"
+)
+
+// CheckStruct lints the rule tags of a struct type, given as a value,
+// a pointer or a reflect.Type, and of the structs it contains. It reports
+// every malformed tag and unknown rule, whether or not the engine is
+// strict, and rules on embedded structs, which validation ignores, so it
+// can run in unit tests or at startup.
+func (e *Engine) CheckStruct(v any) error {
+	typ, ok := v.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(v)
+	}
+
+	if typ == nil {
+		return faults.ErrUnsupportedDataType
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return faults.ErrUnsupportedDataType
+	}
+
+	var errs []error
+	e.checkType(typ, make(map[reflect.Type]bool), &errs)
+	return errors.Join(errs...)
+}
+
+func (e *Engine) checkType(typ reflect.Type, seen map[reflect.Type]bool, errs *[]error) {
+	for {
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			if typ.Kind() == reflect.Map {
+				e.checkType(typ.Key(), seen, errs)
+			}
+			typ = typ.Elem()
+			continue
+		}
+		break
+	}
+
+	if !isNestedStruct(typ) || seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+
+		promoted := e.promoted(structField)
+		if structField.PkgPath != "" && !promoted {
+			continue // unexported
+		}
+
+		if promoted {
+			// compileStruct promotes the fields of embedded structs and
+			// never applies rules to the embedded struct itself.
+			if tag := e.ruleTag(structField); tag != "" {
+				*errs = append(*errs, &TagError{
+					Struct: typ.String(),
+					Field:  structField.Name,
+					Tag:    tag,
+					Reason: "rules on an embedded struct are ignored; name the field to apply them",
+				})
+			}
+		} else if _, err := e.compileField(structField, true); err != nil {
+			*errs = append(*errs, e.fieldTagError(typ, structField, err))
+		}
+
+		e.checkType(structField.Type, seen, errs)
+	}
+}
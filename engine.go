@@ -0,0 +1,442 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/godev90/validator/faults"
+)
+
+type (
+	// Engine owns a rule registry and the settings used to validate
+	// structs. Engines are independent of each other, so libraries and
+	// tests can register rules without affecting the rest of the binary.
+	Engine struct {
+		mu      sync.RWMutex
+		rules   map[string]ruleEntry
+		locale  faults.LanguageTag
+		catalog *faults.YamlPackage
+
+		// patterns are the named expressions used by `pattern=` rules.
+		patterns map[string]*regexp.Regexp
+
+		// enums are the value sets used by `enum=` rules.
+		enums map[string]*enumSet
+
+		// dateLayouts and datetimeLayouts are the layouts accepted by the
+		// date and datetime rules when no layout is given in the tag.
+		dateLayouts     []string
+		datetimeLayouts []string
+
+		// timeLayouts are the ones accepted for strings by the time
+		// rules such as before and past: all of the above and RFC 3339.
+		timeLayouts []string
+
+		// tagNames are the struct tags holding the rules, by precedence.
+		// Fields are reported under the name given by nameFunc.
+		tagNames []string
+		nameFunc TagNameFunc
+
+		// strict makes unknown rule names fail validation instead of
+		// being skipped.
+		strict atomic.Bool
+
+		// collectAll keeps validating a value after its first failing
+		// rule and reports every failure as a faults.ErrorList.
+		collectAll bool
+
+		// plans caches a *structPlan per reflect.Type and varPlans, up
+		// to maxVarPlans, a *valuePlan per type and rule string. They are cleared whenever
+		// the registry changes, because plans hold resolved RuleFuncs,
+		// and generation is bumped so plans compiled from the old
+		// registry are not stored afterwards.
+		plans      sync.Map
+		varPlans   boundedCache
+		generation atomic.Uint64
+	}
+
+	Option func(*Engine)
+
+	// TagNameFunc returns the name a field is reported under and looked
+	// up by in cross-field rules. An empty name, or "-", falls back to
+	// the Go name of the field.
+	TagNameFunc func(field reflect.StructField) string
+
+	// ruleEntry keeps a rule in both shapes, so GetValidator can hand
+	// out a RuleFunc for rules registered as FieldRuleFunc and vice versa.
+	ruleEntry struct {
+		fn      RuleFunc
+		fieldFn FieldRuleFunc
+	}
+)
+
+func valueRuleEntry(fn RuleFunc) ruleEntry {
+	return ruleEntry{
+		fn: fn,
+		fieldFn: func(fl FieldLevel) error {
+			return fn(fl.value, fl.param)
+		},
+	}
+}
+
+func ctxRuleEntry(fn RuleFuncCtx) ruleEntry {
+	return ruleEntry{
+		fn: func(value any, param string) error {
+			return fn(context.Background(), value, param)
+		},
+		fieldFn: func(fl FieldLevel) error {
+			return fn(fl.Context(), fl.value, fl.param)
+		},
+	}
+}
+
+func fieldRuleEntry(fn FieldRuleFunc) ruleEntry {
+	return ruleEntry{
+		fn: func(value any, param string) error {
+			return fn(newValueLevel(value, param))
+		},
+		fieldFn: fn,
+	}
+}
+
+// New creates an engine preloaded with the built-in rules.
+func New(opts ...Option) *Engine {
+	e := &Engine{
+		rules:    make(map[string]ruleEntry, len(builtinRules)),
+		locale:   faults.DefaultLocale,
+		tagNames: []string{"validation", "validate"},
+		nameFunc: NameFromTag("json"),
+
+		varPlans: boundedCache{max: maxVarPlans},
+
+		dateLayouts:     []string{defaultDateLayout},
+		datetimeLayouts: []string{defaultDatetimeLayout},
+	}
+
+	for name, entry := range builtinRules {
+		e.rules[name] = entry
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	e.timeLayouts = slices.Concat(e.dateLayouts, e.datetimeLayouts, []string{time.RFC3339})
+
+	return e
+}
+
+// WithTagName sets the struct tags holding the rules. When a field has
+// several of them, the first one listed wins. By default both "validation"
+// and "validate" are read, in that order.
+func WithTagName(names ...string) Option {
+	return func(e *Engine) {
+		e.tagNames = names
+	}
+}
+
+// WithFieldNameTag reports fields under the name found in the first of the
+// given tags that is set, e.g. "json", "form", "query" or "yaml". The
+// default is "json".
+func WithFieldNameTag(tags ...string) Option {
+	return WithTagNameFunc(NameFromTag(tags...))
+}
+
+// WithTagNameFunc reports fields under the name returned by fn.
+func WithTagNameFunc(fn TagNameFunc) Option {
+	return func(e *Engine) {
+		e.nameFunc = fn
+	}
+}
+
+// NameFromTag returns a TagNameFunc reading the name from the first of
+// the given tags that is set, ignoring options such as ",omitempty".
+func NameFromTag(tags ...string) TagNameFunc {
+	return func(field reflect.StructField) string {
+		for _, tag := range tags {
+			if value, ok := field.Tag.Lookup(tag); ok {
+				return strings.Split(value, ",")[0]
+			}
+		}
+		return ""
+	}
+}
+
+// WithDateLayouts sets the layouts accepted by the date rule, by default
+// only yyyy-mm-dd. Besides Go layouts, typedef.LayoutUnix,
+// typedef.LayoutUnixMilli and names such as "rfc1123" are accepted.
+func WithDateLayouts(layouts ...string) Option {
+	return func(e *Engine) {
+		e.dateLayouts = layouts
+	}
+}
+
+// WithDatetimeLayouts sets the layouts accepted by the datetime rule, by
+// default only yyyy-mm-dd hh:mm:ss.
+func WithDatetimeLayouts(layouts ...string) Option {
+	return func(e *Engine) {
+		e.datetimeLayouts = layouts
+	}
+}
+
+// WithLocale sets the language used by Localize.
+func WithLocale(tag faults.LanguageTag) Option {
+	return func(e *Engine) {
+		e.locale = tag
+	}
+}
+
+// WithCatalog replaces the messages of rule errors with the ones found in
+// catalog under the same key, e.g. to reword builtin_list.yaml entries.
+func WithCatalog(catalog faults.YamlPackage) Option {
+	return func(e *Engine) {
+		e.catalog = &catalog
+	}
+}
+
+// WithCollectAll makes the engine report every failing rule of a field as
+// a faults.ErrorList instead of stopping at the first one.
+func WithCollectAll() Option {
+	return func(e *Engine) {
+		e.collectAll = true
+	}
+}
+
+// WithStrict makes the engine fail with a *TagError on rule names that are
+// not registered, instead of skipping them.
+func WithStrict() Option {
+	return func(e *Engine) {
+		e.strict.Store(true)
+	}
+}
+
+// SetStrict turns strict mode on or off, see WithStrict.
+func (e *Engine) SetStrict(strict bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.strict.Store(strict)
+	e.invalidatePlans()
+}
+
+func (e *Engine) RegisterValidator(name string, fn RuleFunc) {
+	e.register(name, valueRuleEntry(fn))
+}
+
+// RegisterValidatorCtx registers a rule that receives the context given to
+// ValidateStructCtx or ValidateVarCtx, or context.Background otherwise.
+func (e *Engine) RegisterValidatorCtx(name string, fn RuleFuncCtx) {
+	e.register(name, ctxRuleEntry(fn))
+}
+
+// RegisterFieldValidator registers a rule that receives a FieldLevel, which
+// gives access to the parent and top-level structs.
+func (e *Engine) RegisterFieldValidator(name string, fn FieldRuleFunc) {
+	e.register(name, fieldRuleEntry(fn))
+}
+
+func (e *Engine) GetValidator(name string) (RuleFunc, bool) {
+	entry, ok := e.lookupRule(name)
+	return entry.fn, ok
+}
+
+func (e *Engine) register(name string, entry ruleEntry) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rules[name] = entry
+	e.invalidatePlans()
+}
+
+// invalidatePlans drops the cached plans. It must be called with e.mu
+// held for writing.
+func (e *Engine) invalidatePlans() {
+	e.generation.Add(1)
+	e.plans.Clear()
+	e.varPlans.Clear()
+}
+
+func (e *Engine) lookupRule(name string) (ruleEntry, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	entry, ok := e.rules[name]
+	return entry, ok
+}
+
+func (e *Engine) Locale() faults.LanguageTag {
+	return e.locale
+}
+
+func (e *Engine) ValidateStruct(dest any) error {
+	return e.ValidateStructCtx(context.Background(), dest)
+}
+
+// ValidateStructCtx validates dest like ValidateStruct, passing ctx to the
+// rules. It stops and returns the context's error once ctx is done.
+func (e *Engine) ValidateStructCtx(ctx context.Context, dest any) error {
+	return e.validateStruct(ctx, dest, true)
+}
+
+// ValidateFields validates dest like ValidateStruct without calling the
+// Validate method of dest itself, so that method can use it to check the
+// fields of its receiver:
+//
+//	func (o Order) Validate() error {
+//		if err := validator.ValidateFields(o); err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Calling ValidateStruct there instead would call Validate again, forever.
+func (e *Engine) ValidateFields(dest any) error {
+	return e.validateStruct(context.Background(), dest, false)
+}
+
+func (e *Engine) ValidateFieldsCtx(ctx context.Context, dest any) error {
+	return e.validateStruct(ctx, dest, false)
+}
+
+// validateStruct validates dest, calling its Validate method when hook
+// is set.
+func (e *Engine) validateStruct(ctx context.Context, dest any, hook bool) error {
+	var ptr reflect.Value
+	val := reflect.ValueOf(dest)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return faults.ErrCannotBeNull
+		}
+		ptr, val = val, val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return faults.ErrUnsupportedDataType
+	}
+
+	var errors faults.Errors
+	w := newWalker(ctx, e)
+	w.top = val
+	if ptr.IsValid() {
+		w.enter(ptr) // dest may be reachable from its own fields
+	}
+	w.walkStruct(val, nil, &errors, hook)
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+	return nil
+}
+
+// ValidateVar validates a single value against a rule string written like
+// a struct tag, e.g. ValidateVar(email, "required,email"). It returns the
+// error of the failing rule, or faults.Errors keyed by index for the
+// elements checked with dive.
+func (e *Engine) ValidateVar(value any, rules string) error {
+	return e.validateVar(context.Background(), value, reflect.Value{}, rules)
+}
+
+func (e *Engine) ValidateVarCtx(ctx context.Context, value any, rules string) error {
+	return e.validateVar(ctx, value, reflect.Value{}, rules)
+}
+
+// ValidateVarWithValue validates value against rules, with cross-field
+// rules such as eqfield or gtfield comparing it to other instead of a
+// sibling field.
+func (e *Engine) ValidateVarWithValue(value, other any, rules string) error {
+	return e.validateVar(context.Background(), value, reflect.ValueOf(&other).Elem(), rules)
+}
+
+func (e *Engine) validateVar(ctx context.Context, value any, other reflect.Value, rules string) error {
+	// Going through a pointer keeps nil values as a nil interface, which
+	// the walker treats like a nil pointer.
+	val := reflect.ValueOf(&value).Elem()
+	if value != nil {
+		val = val.Elem()
+	}
+
+	plan, err := e.varPlanFor(val.Type(), rules)
+	if err != nil {
+		return err
+	}
+
+	var errors faults.Errors
+	w := newWalker(ctx, e)
+	fl := FieldLevel{engine: e, ctx: ctx, other: other}
+	w.validateField(val, plan, fl, &errors)
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if err, ok := errors[""]; ok && len(errors) == 1 {
+		if fe, ok := err.(faults.FieldError); ok {
+			return fe.Err
+		}
+		return err
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+	return nil
+}
+
+// Localize renders err in the engine's locale: faults.Errors become a map
+// of messages, any other error a single message.
+func (e *Engine) Localize(err error) any {
+	switch er := err.(type) {
+	case nil:
+		return nil
+	case faults.Errors:
+		return er.LocalizedError(e.locale)
+	case faults.ErrorList:
+		return er.LocalizedError(e.locale)
+	case interface {
+		LocalizedError(faults.LanguageTag) string
+	}:
+		return er.LocalizedError(e.locale)
+	default:
+		return er.Error()
+	}
+}
+
+// translate applies the engine's catalog to an error returned by a rule.
+func (e *Engine) translate(err error) error {
+	if e.catalog == nil {
+		return err
+	}
+
+	if er, ok := err.(faults.Error); ok {
+		return e.catalog.Translate(er)
+	}
+	return err
+}
+
+// ruleTag returns the rules of a field from the first of the engine's tag
+// names it has.
+func (e *Engine) ruleTag(field reflect.StructField) string {
+	for _, name := range e.tagNames {
+		if tag, ok := field.Tag.Lookup(name); ok {
+			return tag
+		}
+	}
+	return ""
+}
+
+// fieldName returns the name a field is reported under, and whether it
+// was set explicitly rather than taken from the Go name.
+func (e *Engine) fieldName(field reflect.StructField) (string, bool) {
+	name := e.nameFunc(field)
+	if name == "" || name == "-" {
+		return field.Name, false
+	}
+	return name, true
+}
@@ -77,6 +77,77 @@ func init() {
 	ErrInvalidNumericFormat = builtin("err_invalid_numeric_format")
 	ErrInvalidFloatNumber = builtin("err_invalid_float_number")
 	ErrInvalidIntegerNumber = builtin("err_invalid_integer_number")
+	ErrMustEqualField = builtin("err_must_equal_field")
+	ErrMustNotEqualField = builtin("err_must_not_equal_field")
+	ErrMustBeGreaterThanField = builtin("err_must_be_greater_than_field")
+	ErrMustBeGreaterOrEqualField = builtin("err_must_be_greater_or_equal_field")
+	ErrMustBeLessThanField = builtin("err_must_be_less_than_field")
+	ErrMustBeLessOrEqualField = builtin("err_must_be_less_or_equal_field")
+	ErrRequiredIf = builtin("err_required_if")
+	ErrRequiredUnless = builtin("err_required_unless")
+	ErrRequiredWith = builtin("err_required_with")
+	ErrRequiredWithout = builtin("err_required_without")
+	ErrExcludedIf = builtin("err_excluded_if")
+	ErrLengthNotExact = builtin("err_length_not_exact")
+	ErrItemsBelowMinimum = builtin("err_items_below_minimum")
+	ErrItemsAboveMaximum = builtin("err_items_above_maximum")
+	ErrItemsNotExact = builtin("err_items_not_exact")
+	ErrMustBeURL = builtin("err_must_be_url")
+	ErrMustBeURI = builtin("err_must_be_uri")
+	ErrMustBeHostname = builtin("err_must_be_hostname")
+	ErrMustBeIP = builtin("err_must_be_ip")
+	ErrMustBeIPv4 = builtin("err_must_be_ipv4")
+	ErrMustBeIPv6 = builtin("err_must_be_ipv6")
+	ErrMustBeCIDR = builtin("err_must_be_cidr")
+	ErrMustBeMAC = builtin("err_must_be_mac")
+	ErrMustBePort = builtin("err_must_be_port")
+	ErrMustBeUUID = builtin("err_must_be_uuid")
+	ErrMustBeULID = builtin("err_must_be_ulid")
+	ErrMustMatchPattern = builtin("err_must_match_pattern")
+	ErrMustBeNIK = builtin("err_must_be_nik")
+	ErrMustBeNPWP = builtin("err_must_be_npwp")
+	ErrMustBePhoneID = builtin("err_must_be_phone_id")
+	ErrMustBePostcodeID = builtin("err_must_be_postcode_id")
+	ErrMustBeBankAccount = builtin("err_must_be_bank_account")
+	ErrMustBePlateID = builtin("err_must_be_plate_id")
+	ErrMustBeCreditCard = builtin("err_must_be_credit_card")
+	ErrMustBeIBAN = builtin("err_must_be_iban")
+	ErrMustBeISBN = builtin("err_must_be_isbn")
+	ErrMustBeEAN = builtin("err_must_be_ean")
+	ErrMustBeUPC = builtin("err_must_be_upc")
+	ErrMustBeBIC = builtin("err_must_be_bic")
+	ErrMustContain = builtin("err_must_contain")
+	ErrMustNotContain = builtin("err_must_not_contain")
+	ErrMustStartWith = builtin("err_must_start_with")
+	ErrMustEndWith = builtin("err_must_end_with")
+	ErrMustBeLowercase = builtin("err_must_be_lowercase")
+	ErrMustBeUppercase = builtin("err_must_be_uppercase")
+	ErrMustBeASCII = builtin("err_must_be_ascii")
+	ErrMustBePrintable = builtin("err_must_be_printable")
+	ErrMustBeTrimmed = builtin("err_must_be_trimmed")
+	ErrMustNotContainWhitespace = builtin("err_must_not_contain_whitespace")
+	ErrMustBeBefore = builtin("err_must_be_before")
+	ErrMustBeAfter = builtin("err_must_be_after")
+	ErrMustBeInPast = builtin("err_must_be_in_past")
+	ErrMustBeInFuture = builtin("err_must_be_in_future")
+	ErrAgeBelowMinimum = builtin("err_age_below_minimum")
+	ErrAgeAboveMaximum = builtin("err_age_above_maximum")
+	ErrMustBeWithin = builtin("err_must_be_within")
+	ErrInvalidDateLayout = builtin("err_invalid_date_layout")
+	ErrInvalidDatetimeLayout = builtin("err_invalid_datetime_layout")
+	ErrMustBeGreaterThan = builtin("err_must_be_greater_than")
+	ErrMustBeLessThan = builtin("err_must_be_less_than")
+	ErrMustEqual = builtin("err_must_equal")
+	ErrMustNotEqual = builtin("err_must_not_equal")
+	ErrMustBeBetween = builtin("err_must_be_between")
+	ErrMustBeMultipleOf = builtin("err_must_be_multiple_of")
+	ErrScaleAboveMaximum = builtin("err_scale_above_maximum")
+	ErrPrecisionAboveMaximum = builtin("err_precision_above_maximum")
+	ErrMustBePositive = builtin("err_must_be_positive")
+	ErrMustBeNegative = builtin("err_must_be_negative")
+	ErrMustBeNonzero = builtin("err_must_be_nonzero")
+	ErrMustBeFinite = builtin("err_must_be_finite")
+	ErrInvalidEnum = builtin("err_invalid_enum")
 }
 
 var (
@@ -141,4 +212,76 @@ var (
 	ErrInvalidNumericFormat   Error
 	ErrInvalidFloatNumber     Error
 	ErrInvalidIntegerNumber   Error
+
+	ErrMustEqualField            Error
+	ErrMustNotEqualField         Error
+	ErrMustBeGreaterThanField    Error
+	ErrMustBeGreaterOrEqualField Error
+	ErrMustBeLessThanField       Error
+	ErrMustBeLessOrEqualField    Error
+	ErrRequiredIf                Error
+	ErrRequiredUnless            Error
+	ErrRequiredWith              Error
+	ErrRequiredWithout           Error
+	ErrExcludedIf                Error
+	ErrLengthNotExact            Error
+	ErrItemsBelowMinimum         Error
+	ErrItemsAboveMaximum         Error
+	ErrItemsNotExact             Error
+	ErrMustBeURL                 Error
+	ErrMustBeURI                 Error
+	ErrMustBeHostname            Error
+	ErrMustBeIP                  Error
+	ErrMustBeIPv4                Error
+	ErrMustBeIPv6                Error
+	ErrMustBeCIDR                Error
+	ErrMustBeMAC                 Error
+	ErrMustBePort                Error
+	ErrMustBeUUID                Error
+	ErrMustBeULID                Error
+	ErrMustMatchPattern          Error
+	ErrMustBeNIK                 Error
+	ErrMustBeNPWP                Error
+	ErrMustBePhoneID             Error
+	ErrMustBePostcodeID          Error
+	ErrMustBeBankAccount         Error
+	ErrMustBePlateID             Error
+	ErrMustBeCreditCard          Error
+	ErrMustBeIBAN                Error
+	ErrMustBeISBN                Error
+	ErrMustBeEAN                 Error
+	ErrMustBeUPC                 Error
+	ErrMustBeBIC                 Error
+	ErrMustContain               Error
+	ErrMustNotContain            Error
+	ErrMustStartWith             Error
+	ErrMustEndWith               Error
+	ErrMustBeLowercase           Error
+	ErrMustBeUppercase           Error
+	ErrMustBeASCII               Error
+	ErrMustBePrintable           Error
+	ErrMustBeTrimmed             Error
+	ErrMustNotContainWhitespace  Error
+	ErrMustBeBefore              Error
+	ErrMustBeAfter               Error
+	ErrMustBeInPast              Error
+	ErrMustBeInFuture            Error
+	ErrAgeBelowMinimum           Error
+	ErrAgeAboveMaximum           Error
+	ErrMustBeWithin              Error
+	ErrInvalidDateLayout         Error
+	ErrInvalidDatetimeLayout     Error
+	ErrMustBeGreaterThan         Error
+	ErrMustBeLessThan            Error
+	ErrMustEqual                 Error
+	ErrMustNotEqual              Error
+	ErrMustBeBetween             Error
+	ErrMustBeMultipleOf          Error
+	ErrScaleAboveMaximum         Error
+	ErrPrecisionAboveMaximum     Error
+	ErrMustBePositive            Error
+	ErrMustBeNegative            Error
+	ErrMustBeNonzero             Error
+	ErrMustBeFinite              Error
+	ErrInvalidEnum               Error
 )
@@ -16,14 +16,27 @@ type (
 		code          ErrCode
 		err           error
 		localMessages map[LanguageTag]string
+
+		// key is the catalog entry the error was built from and args
+		// the arguments it was rendered with, so it can be re-rendered
+		// from another catalog.
+		key  string
+		args []any
 	}
 
 	Errors map[string]error
 
+	// ErrorList holds every failure of a single field, in rule order.
+	ErrorList []error
+
 	ErrAttr struct {
 		Code     ErrCode
 		Messages []LangPackage
 	}
+
+	localizer interface {
+		LocalizedError(tag LanguageTag) string
+	}
 )
 
 func builtin(key string) Error {
@@ -32,6 +45,7 @@ func builtin(key string) Error {
 		code:          http.StatusInternalServerError,
 		err:           errors.New(errmsg),
 		localMessages: make(map[LanguageTag]string),
+		key:           key,
 	}
 
 	if langPack, found := builtinYaml.Packages[key]; found {
@@ -70,6 +84,10 @@ func New(err error, attr *ErrAttr, args ...any) Error {
 }
 
 func Is(err error, target error) bool {
+	if fe, ok := err.(FieldError); ok {
+		err = fe.Err
+	}
+
 	if er, ok := err.(Error); ok {
 		err = er.err
 	}
@@ -85,6 +103,20 @@ func (err Error) Code() ErrCode {
 	return err.code
 }
 
+// Is makes errors.Is match two Errors created from the same definition,
+// including through wrappers such as FieldError.
+func (err Error) Is(target error) bool {
+	if er, ok := target.(Error); ok {
+		return err.err != nil && err.err == er.err
+	}
+	return false
+}
+
+// Key returns the catalog key the error was created from, if any.
+func (err Error) Key() string {
+	return err.key
+}
+
 func (err Error) Error() string {
 	if err.err != nil {
 		if message, ok := err.localMessages[English]; ok {
@@ -127,6 +159,8 @@ func (err Error) Render(args ...any) Error {
 		code:          err.code,
 		err:           err.err,
 		localMessages: clonedMessages,
+		key:           err.key,
+		args:          args,
 	}
 
 	for _, t := range cpy.SupportedTags() {
@@ -158,6 +192,8 @@ func (errs Errors) Error() string {
 
 		if ers, ok := errs[key].(Errors); ok {
 			_, _ = fmt.Fprintf(&s, "%v: (%v)", key, ers)
+		} else if ers, ok := errs[key].(ErrorList); ok {
+			_, _ = fmt.Fprintf(&s, "%v: [%v]", key, ers)
 		} else if er, ok := errs[key].(Error); ok {
 			if message, ok := er.localMessages[English]; ok {
 				_, _ = fmt.Fprintf(&s, "%v: %v", key, message)
@@ -183,7 +219,9 @@ func (errs Errors) LocalizedError(tag LanguageTag) map[string]any {
 	for key, err := range errs {
 		if ers, ok := err.(Errors); ok {
 			result[key] = ers.LocalizedError(tag)
-		} else if er, ok := errs[key].(Error); ok {
+		} else if ers, ok := err.(ErrorList); ok {
+			result[key] = ers.LocalizedError(tag)
+		} else if er, ok := err.(localizer); ok {
 			result[key] = er.LocalizedError(tag)
 		} else {
 			result[key] = err.Error()
@@ -193,8 +231,41 @@ func (errs Errors) LocalizedError(tag LanguageTag) map[string]any {
 	return result
 }
 
+// MarshalJSON returns the errors as a JSON object of messages, with lists
+// for fields holding several failures
+func (errs Errors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errs.LocalizedError(English))
+}
+
 // MarshalJSON returns the error as a JSON string
 func (e Error) MarshalJSON() ([]byte, error) {
 	// This will output: "some error string"
 	return json.Marshal(e.Error())
 }
+
+func (errs ErrorList) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (errs ErrorList) LocalizedError(tag LanguageTag) []string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		if er, ok := err.(localizer); ok {
+			messages[i] = er.LocalizedError(tag)
+		} else {
+			messages[i] = err.Error()
+		}
+	}
+
+	return messages
+}
+
+// MarshalJSON returns the list as a JSON array of messages
+func (errs ErrorList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errs.LocalizedError(English))
+}
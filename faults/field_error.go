@@ -0,0 +1,67 @@
+package faults
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// FieldError describes a failed rule on a single field. It wraps the error
+// returned by the rule, usually an Error, and is what validator stores in
+// Errors. Parse errors of typedef values are reported with the rule
+// "parse" and errors of Validate methods with the rule "validate".
+type FieldError struct {
+	// Rule and Param are the failing rule and its parameter as written
+	// in the tag, e.g. "min" and "18".
+	Rule  string
+	Param string
+
+	// Namespace is the path of the field using reported names, e.g.
+	// "items[3].sku", and StructNamespace the same path using Go names,
+	// e.g. "Items[3].SKU".
+	Namespace       string
+	StructNamespace string
+
+	// Field is the name the field is reported under and StructField its
+	// Go name.
+	Field       string
+	StructField string
+
+	// Kind and Value describe the offending value, with pointers
+	// dereferenced. Value is nil for nil pointers.
+	Kind  reflect.Kind
+	Value any
+
+	Err error
+}
+
+func (fe FieldError) Error() string {
+	if fe.Err == nil {
+		return "validator: " + fe.Rule + " failed on " + fe.Namespace + "."
+	}
+	return fe.Err.Error()
+}
+
+func (fe FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// Code returns the code of the wrapped Error, or ErrUnprocessable's code
+// for other errors.
+func (fe FieldError) Code() ErrCode {
+	if er, ok := fe.Err.(Error); ok {
+		return er.Code()
+	}
+	return ErrUnprocessable.Code()
+}
+
+func (fe FieldError) LocalizedError(tag LanguageTag) string {
+	if er, ok := fe.Err.(Error); ok {
+		return er.LocalizedError(tag)
+	}
+	return fe.Error()
+}
+
+// MarshalJSON returns the error as a JSON string
+func (fe FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fe.Error())
+}
@@ -69,6 +69,7 @@ func (yml YamlPackage) NewError(key string) Error {
 		code:          http.StatusInternalServerError,
 		err:           errors.New(errmsg),
 		localMessages: make(map[LanguageTag]string),
+		key:           key,
 	}
 
 	if langPack, found := yml.Packages[key]; found {
@@ -81,3 +82,35 @@ func (yml YamlPackage) NewError(key string) Error {
 
 	return err
 }
+
+// Translate returns err with the code and messages yml holds for the same
+// key, rendered with the arguments err was rendered with. The identity of
+// err is kept, so Is still matches the original error.
+func (yml YamlPackage) Translate(err Error) Error {
+	langPack, found := yml.Packages[err.key]
+	if !found {
+		return err
+	}
+
+	translated := Error{
+		code:          err.code,
+		err:           err.err,
+		localMessages: make(map[LanguageTag]string, len(langPack.Messages)),
+		key:           err.key,
+	}
+
+	if langPack.Code != 0 {
+		translated.code = langPack.Code
+	}
+
+	for _, msg := range langPack.Messages {
+		if msg.Message != "" {
+			translated.localMessages[msg.Tag] = msg.Message
+		}
+	}
+
+	if err.args != nil {
+		return translated.Render(err.args...)
+	}
+	return translated
+}
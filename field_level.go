@@ -0,0 +1,195 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldLevel describes the value being validated together with the struct
+// it belongs to, for rules that need to look beyond the value itself.
+type FieldLevel struct {
+	engine *Engine
+	ctx    context.Context
+	value  any
+	field  reflect.Value
+	parent reflect.Value
+	top    reflect.Value
+	param  string
+	name   string
+	goName string
+
+	namespace       string
+	structNamespace string
+
+	// other replaces the field named by the parameter of cross-field
+	// rules for ValidateVarWithValue.
+	other reflect.Value
+}
+
+// newValueLevel describes a value validated outside of any struct.
+func newValueLevel(value any, param string) FieldLevel {
+	return FieldLevel{
+		value: value,
+		field: reflect.ValueOf(value),
+		param: param,
+	}
+}
+
+// engineOrDefault returns the engine running the rule, or the default
+// engine for rules called through GetValidator.
+func (fl FieldLevel) engineOrDefault() *Engine {
+	if fl.engine == nil {
+		return std
+	}
+	return fl.engine
+}
+
+// Context returns the context given to ValidateStructCtx or
+// ValidateVarCtx, or context.Background.
+func (fl FieldLevel) Context() context.Context {
+	if fl.ctx == nil {
+		return context.Background()
+	}
+	return fl.ctx
+}
+
+// Value returns the value being validated, with pointers dereferenced. It
+// is nil for nil pointers.
+func (fl FieldLevel) Value() any {
+	return fl.value
+}
+
+// Field returns the value being validated as a reflect.Value.
+func (fl FieldLevel) Field() reflect.Value {
+	return fl.field
+}
+
+// Parent returns the struct holding the field. It is invalid when a value
+// is validated on its own.
+func (fl FieldLevel) Parent() reflect.Value {
+	return fl.parent
+}
+
+// Top returns the struct passed to ValidateStruct.
+func (fl FieldLevel) Top() reflect.Value {
+	return fl.top
+}
+
+func (fl FieldLevel) Param() string {
+	return fl.param
+}
+
+// FieldName returns the name the field is reported under, e.g. its JSON name.
+func (fl FieldLevel) FieldName() string {
+	return fl.name
+}
+
+// StructFieldName returns the Go name of the field.
+func (fl FieldLevel) StructFieldName() string {
+	return fl.goName
+}
+
+// Namespace returns the path of the field using reported names, e.g.
+// "items[3].sku".
+func (fl FieldLevel) Namespace() string {
+	return fl.namespace
+}
+
+// StructNamespace returns the path of the field using Go names.
+func (fl FieldLevel) StructNamespace() string {
+	return fl.structNamespace
+}
+
+// index describes the element of the field at key, as reached with dive.
+func (fl FieldLevel) index(key any) FieldLevel {
+	suffix := fmt.Sprintf("[%v]", key)
+
+	fl.name += suffix
+	fl.goName += suffix
+	fl.namespace += suffix
+	fl.structNamespace += suffix
+	return fl
+}
+
+// Lookup finds a field of the parent struct by its Go or reported name.
+// Dotted paths such as "Address.City" descend into nested structs.
+func (fl FieldLevel) Lookup(path string) (reflect.Value, bool) {
+	current := fl.parent
+
+	for _, name := range strings.Split(path, ".") {
+		for current.Kind() == reflect.Ptr || current.Kind() == reflect.Interface {
+			if current.IsNil() {
+				return reflect.Value{}, false
+			}
+			current = current.Elem()
+		}
+
+		if current.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		field, ok := fl.engine.lookupField(current, name)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		current = field
+	}
+
+	return current, true
+}
+
+// lookupField finds an exported field of val by Go name, falling back to
+// the name it is reported under, including fields promoted from embedded
+// structs.
+func (e *Engine) lookupField(val reflect.Value, name string) (reflect.Value, bool) {
+	if sf, ok := val.Type().FieldByName(name); ok && sf.PkgPath == "" {
+		if field, err := val.FieldByIndexErr(sf.Index); err == nil {
+			return field, true
+		}
+		return reflect.Value{}, false
+	}
+	return e.lookupPromoted(val, name, nil)
+}
+
+// lookupPromoted finds a field by the name it is reported under, following
+// embedded pointers once each, as the walker does, so cyclic embedding
+// ends.
+func (e *Engine) lookupPromoted(val reflect.Value, name string, visiting map[visitKey]struct{}) (reflect.Value, bool) {
+	plan := e.structPlanFor(val.Type())
+	for i := range plan.fields {
+		fp := &plan.fields[i]
+		field := val.Field(fp.index)
+
+		if !fp.embedded {
+			if fp.name == name {
+				return field, true
+			}
+			continue
+		}
+
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue
+			}
+
+			key := visitKey{typ: field.Type(), addr: field.Pointer()}
+			if _, seen := visiting[key]; seen {
+				continue
+			}
+			if visiting == nil {
+				visiting = make(map[visitKey]struct{})
+			}
+			visiting[key] = struct{}{}
+
+			field = field.Elem()
+		}
+
+		if found, ok := e.lookupPromoted(field, name, visiting); ok {
+			return found, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
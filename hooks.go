@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"reflect"
+
+	"github.com/godev90/validator/faults"
+	"github.com/godev90/validator/typedef"
+)
+
+// StructErrorKey holds the error returned by a struct's own Validate method
+// when it is not a faults.Errors that can be merged field by field.
+const StructErrorKey = "_struct"
+
+var (
+	validatorType = reflect.TypeOf((*Validator)(nil)).Elem()
+	enumType      = reflect.TypeOf((*Enum)(nil)).Elem()
+)
+
+// traits describes how values of a type take part in validation.
+type traits struct {
+	// nested structs have their fields validated recursively.
+	nested bool
+
+	// validatable values report parse errors through Err.
+	validatable bool
+
+	// validator values are checked by calling their Validate method.
+	validator bool
+}
+
+func traitsOf(typ reflect.Type) traits {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return traits{
+		nested:      isNestedStruct(typ),
+		validatable: implements(typ, validatableType),
+		validator:   implements(typ, validatorType),
+	}
+}
+
+func implements(typ, iface reflect.Type) bool {
+	return typ.Implements(iface) || reflect.PointerTo(typ).Implements(iface)
+}
+
+// asInterface returns val, or its address for methods with pointer
+// receivers, as an interface value implementing iface.
+func asInterface(val reflect.Value, iface reflect.Type) (any, bool) {
+	if !val.CanInterface() {
+		return nil, false // e.g. an embedded struct of unexported type
+	}
+
+	if val.Type().Implements(iface) {
+		return val.Interface(), true
+	}
+
+	if !reflect.PointerTo(val.Type()).Implements(iface) {
+		return nil, false
+	}
+
+	if val.CanAddr() {
+		return val.Addr().Interface(), true
+	}
+
+	ptr := reflect.New(val.Type())
+	ptr.Elem().Set(val)
+	return ptr.Interface(), true
+}
+
+func parseError(val reflect.Value) error {
+	if v, ok := asInterface(val, validatableType); ok {
+		return v.(typedef.Validatable).Err()
+	}
+	return nil
+}
+
+func callValidate(val reflect.Value) error {
+	if v, ok := asInterface(val, validatorType); ok {
+		return v.(Validator).Validate()
+	}
+	return nil
+}
+
+// structHook calls the Validate method of a struct after its fields were
+// validated and merges the result into errors. Field errors win over the
+// ones reported by the hook for the same key. Validate methods check their
+// receiver with ValidateFields, which skips this hook, so it is not
+// called again.
+func structHook(val reflect.Value, errors *faults.Errors) {
+	err := callValidate(val)
+	if err == nil {
+		return
+	}
+
+	if ers, ok := err.(faults.Errors); ok {
+		for key, er := range ers {
+			if _, exists := (*errors)[key]; !exists {
+				addError(errors, key, er)
+			}
+		}
+		return
+	}
+
+	if _, exists := (*errors)[StructErrorKey]; !exists {
+		addError(errors, StructErrorKey, err)
+	}
+}
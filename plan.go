@@ -0,0 +1,347 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// structPlan is the precompiled form of a struct type's tags, so
+	// repeated validation of the same type does no tag parsing.
+	structPlan struct {
+		fields []fieldPlan
+		hook   bool
+
+		// err reports the first malformed tag of the struct.
+		err error
+	}
+
+	fieldPlan struct {
+		index    int
+		name     string
+		goName   string
+		embedded bool
+		value    valuePlan
+	}
+
+	// valuePlan holds the rules for a single value. The presence rules
+	// are the subset that also runs on nil values. For containers, keys
+	// and elem hold the rules following `dive`. Values of interface type
+	// are dynamic: their traits are only known at run time.
+	valuePlan struct {
+		rules    []compiledRule
+		presence []compiledRule
+		traits   traits
+		dynamic  bool
+		dive     bool
+		keys     *valuePlan
+		elem     *valuePlan
+	}
+
+	// compiledRule is a resolved rule, or a group of alternatives of
+	// which one has to pass.
+	compiledRule struct {
+		name     string
+		param    string
+		fn       FieldRuleFunc
+		presence bool
+		alts     []compiledRule
+	}
+)
+
+// planCache is implemented by sync.Map and boundedCache.
+type planCache interface {
+	LoadOrStore(key, value any) (any, bool)
+}
+
+// varKey identifies the plan of a value validated with ValidateVar.
+type varKey struct {
+	typ   reflect.Type
+	rules string
+}
+
+func (e *Engine) varPlanFor(typ reflect.Type, rules string) (*valuePlan, error) {
+	key := varKey{typ: typ, rules: rules}
+	if plan, ok := e.varPlans.Load(key); ok {
+		return plan.(*valuePlan), nil
+	}
+
+	gen := e.generation.Load()
+	groups, err := parseTag(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, err := e.compileValue(typ, groups, e.strict.Load())
+	if err != nil {
+		if tagErr, ok := err.(*TagError); ok {
+			tagErr.Tag = rules
+		}
+		return nil, err
+	}
+
+	return e.cachePlan(&e.varPlans, key, &compiled, gen).(*valuePlan), nil
+}
+
+func (e *Engine) structPlanFor(typ reflect.Type) *structPlan {
+	if plan, ok := e.plans.Load(typ); ok {
+		return plan.(*structPlan)
+	}
+
+	gen := e.generation.Load()
+	return e.cachePlan(&e.plans, typ, e.compileStruct(typ), gen).(*structPlan)
+}
+
+// cachePlan stores a plan compiled at registry generation gen, unless the
+// registry changed in the meantime: the plan may then hold stale rules, so
+// it only serves the current call.
+func (e *Engine) cachePlan(cache planCache, key, plan any, gen uint64) any {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.generation.Load() != gen {
+		return plan
+	}
+
+	actual, _ := cache.LoadOrStore(key, plan)
+	return actual
+}
+
+func (e *Engine) compileStruct(typ reflect.Type) *structPlan {
+	plan := &structPlan{
+		fields: make([]fieldPlan, 0, typ.NumField()),
+		hook:   implements(typ, validatorType),
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+
+		promoted := e.promoted(structField)
+		if structField.PkgPath != "" && !promoted {
+			continue // unexported
+		}
+
+		if promoted {
+			// embedded struct: its fields are promoted to this level
+			plan.fields = append(plan.fields, fieldPlan{index: i, embedded: true})
+			continue
+		}
+
+		fieldName, _ := e.fieldName(structField)
+
+		value, err := e.compileField(structField, e.strict.Load())
+		if err != nil {
+			if plan.err == nil {
+				plan.err = e.fieldTagError(typ, structField, err)
+			}
+			continue
+		}
+
+		plan.fields = append(plan.fields, fieldPlan{
+			index:  i,
+			name:   fieldName,
+			goName: structField.Name,
+			value:  value,
+		})
+	}
+
+	return plan
+}
+
+// promoted reports whether the fields of an embedded struct are validated
+// as if declared in the outer struct. Like encoding/json, this includes
+// unexported struct types, whose exported fields remain reachable.
+// Embedded structs given a name, e.g. through a json tag, are validated
+// like any other field.
+func (e *Engine) promoted(structField reflect.StructField) bool {
+	_, named := e.fieldName(structField)
+	return structField.Anonymous && !named && isNestedStruct(structField.Type)
+}
+
+// compileField compiles the rules of a struct field. Unknown rules are
+// skipped unless strict is set.
+func (e *Engine) compileField(structField reflect.StructField, strict bool) (valuePlan, error) {
+	groups, err := parseTag(e.ruleTag(structField))
+	if err != nil {
+		return valuePlan{}, err
+	}
+
+	return e.compileValue(structField.Type, groups, strict)
+}
+
+// fieldTagError completes a *TagError with the field it was found on.
+func (e *Engine) fieldTagError(typ reflect.Type, structField reflect.StructField, err error) error {
+	if tagErr, ok := err.(*TagError); ok {
+		tagErr.Struct = typ.String()
+		tagErr.Field = structField.Name
+		tagErr.Tag = e.ruleTag(structField)
+	}
+	return err
+}
+
+func (e *Engine) compileValue(typ reflect.Type, groups []tagGroup, strict bool) (valuePlan, error) {
+	own, keyRules, elemRules, dive, err := splitDive(groups)
+	if err != nil {
+		return valuePlan{}, err
+	}
+
+	rules, err := e.compileRules(own, strict)
+	if err != nil {
+		return valuePlan{}, err
+	}
+
+	plan := valuePlan{
+		rules:   rules,
+		traits:  traitsOf(typ),
+		dynamic: typ.Kind() == reflect.Interface,
+		dive:    dive,
+	}
+
+	for _, rule := range plan.rules {
+		if rule.presence {
+			plan.presence = append(plan.presence, rule)
+		}
+	}
+
+	if !dive {
+		return plan, nil
+	}
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	var keyType, elemType reflect.Type
+	switch typ.Kind() {
+	case reflect.Map:
+		keyType, elemType = typ.Key(), typ.Elem()
+	case reflect.Slice, reflect.Array:
+		elemType = typ.Elem()
+	default:
+		// resolved at run time, e.g. for interface values
+		keyType, elemType = typ, typ
+	}
+
+	if len(keyRules) > 0 && keyType != nil {
+		keys, err := e.compileValue(keyType, keyRules, strict)
+		if err != nil {
+			return valuePlan{}, err
+		}
+		plan.keys = &keys
+	}
+
+	elem, err := e.compileValue(elemType, elemRules, strict)
+	if err != nil {
+		return valuePlan{}, err
+	}
+	plan.elem = &elem
+
+	return plan, nil
+}
+
+func (e *Engine) compileRules(groups []tagGroup, strict bool) ([]compiledRule, error) {
+	compiled := make([]compiledRule, 0, len(groups))
+
+	for _, group := range groups {
+		alts := make([]compiledRule, 0, len(group))
+
+		for _, rule := range group {
+			entry, ok := e.lookupRule(rule.name)
+			if !ok {
+				if strict {
+					return nil, &TagError{Pos: rule.pos, Reason: fmt.Sprintf("unknown rule %q", rule.name)}
+				}
+				continue // unregistered validator
+			}
+
+			if err := e.checkParam(rule, strict); err != nil {
+				return nil, err
+			}
+
+			alts = append(alts, compiledRule{
+				name:     rule.name,
+				param:    rule.param,
+				fn:       entry.fieldFn,
+				presence: presenceRules[rule.name],
+			})
+		}
+
+		switch len(alts) {
+		case 0:
+		case 1:
+			compiled = append(compiled, alts[0])
+		default:
+			compiled = append(compiled, alternatives(alts))
+		}
+	}
+
+	return compiled, nil
+}
+
+// alternatives combines rules of which one has to pass. The group counts
+// as a presence rule only if all of its rules are.
+func alternatives(alts []compiledRule) compiledRule {
+	names := make([]string, len(alts))
+	presence := true
+
+	for i, alt := range alts {
+		names[i] = alt.name
+		presence = presence && alt.presence
+	}
+
+	return compiledRule{
+		name:     strings.Join(names, "|"),
+		presence: presence,
+		alts:     alts,
+	}
+}
+
+// check runs the rule, or its alternatives until one passes. A failing
+// group reports the error of its first alternative.
+func (rule *compiledRule) check(fl FieldLevel) error {
+	if rule.alts == nil {
+		fl.param = rule.param
+		return rule.fn(fl)
+	}
+
+	var first error
+	for i := range rule.alts {
+		err := rule.alts[i].check(fl)
+		if err == nil {
+			return nil
+		}
+
+		if first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// splitDive separates the rules that apply to a value from the ones that
+// follow a `dive` keyword. A `keys ... endkeys` block directly after dive
+// holds the rules for map keys.
+func splitDive(groups []tagGroup) (own, keyRules, elemRules []tagGroup, dive bool, err error) {
+	for i, group := range groups {
+		if !group.keyword("dive") {
+			continue
+		}
+
+		own, elemRules = groups[:i], groups[i+1:]
+
+		if len(elemRules) > 0 && elemRules[0].keyword("keys") {
+			for j := 1; j < len(elemRules); j++ {
+				if elemRules[j].keyword("endkeys") {
+					return own, elemRules[1:j], elemRules[j+1:], true, nil
+				}
+			}
+
+			return nil, nil, nil, false, &TagError{Pos: elemRules[0][0].pos, Reason: "keys without endkeys"}
+		}
+
+		return own, nil, elemRules, true, nil
+	}
+
+	return groups, nil, nil, false, nil
+}
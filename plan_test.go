@@ -0,0 +1,57 @@
+package validator
+
+import "testing"
+
+type benchAddress struct {
+	Street string `json:"street" validate:"required,maxlen=100"`
+	City   string `json:"city" validate:"required,alphabet"`
+	Zip    string `json:"zip" validate:"required,digit,len=5"`
+}
+
+type benchUser struct {
+	Name     string       `json:"name" validate:"required,minlen=2,maxlen=50"`
+	Email    string       `json:"email" validate:"required,email"`
+	Age      int          `json:"age" validate:"min=17,max=120"`
+	Password string       `json:"password" validate:"required,minlen=8"`
+	Confirm  string       `json:"confirm" validate:"eqfield=Password"`
+	Tags     []string     `json:"tags" validate:"maxlen=5,dive,required,maxlen=20"`
+	Address  benchAddress `json:"address"`
+}
+
+var benchValue = benchUser{
+	Name:     "Budi",
+	Email:    "budi@example.com",
+	Age:      30,
+	Password: "secret123",
+	Confirm:  "secret123",
+	Tags:     []string{"admin", "ops"},
+	Address:  benchAddress{Street: "Jl. Sudirman 1", City: "Jakarta", Zip: "10220"},
+}
+
+// BenchmarkValidateStruct compares validation with the cached plan against
+// validation that has to compile the plan on every call.
+func BenchmarkValidateStruct(b *testing.B) {
+	b.Run("cached", func(b *testing.B) {
+		e := New()
+		if err := e.ValidateStruct(&benchValue); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = e.ValidateStruct(&benchValue)
+		}
+	})
+
+	b.Run("cold", func(b *testing.B) {
+		e := New()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			e.plans.Clear()
+			_ = e.ValidateStruct(&benchValue)
+		}
+	})
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
-	"strconv"
-	"time"
+	"slices"
+	"strings"
 
 	"github.com/godev90/validator/faults"
 	"github.com/godev90/validator/typedef"
@@ -18,6 +18,12 @@ var (
 	emailRe    = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 	nameRe     = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_ '. ,]*$`)
 	textRe     = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_ '. ,!@#$%^&*()\-+=\[\]{}:;<>?/|~]*$`)
+	pipeRe     = regexp.MustCompile(`\s*\|\s*`)
+)
+
+const (
+	defaultDateLayout     = "2006-01-02"
+	defaultDatetimeLayout = "2006-01-02 15:04:05"
 )
 
 func requiredRule(value any, _ string) error {
@@ -34,128 +40,6 @@ func requiredRule(value any, _ string) error {
 	return nil
 }
 
-func minRule(value any, param string) error {
-	minVal, err := strconv.ParseFloat(param, 64)
-	if err != nil {
-		return faults.ErrInvalidParameter.Render(param)
-	}
-
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.String:
-		if f, err := strconv.ParseFloat(val.String(), 64); err == nil {
-			if f < minVal {
-				return faults.ErrBelowMinimum.Render(int64(minVal))
-			}
-		} else {
-			return faults.ErrInvalidNumericFormat
-		}
-
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if float64(val.Int()) < minVal {
-			return faults.ErrBelowMinimum.Render(int64(minVal))
-		}
-
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if float64(val.Uint()) < minVal {
-			return faults.ErrBelowMinimum.Render(int64(minVal))
-		}
-
-	case reflect.Float32, reflect.Float64:
-		if val.Float() < minVal {
-			return faults.ErrBelowMinimum.Render(int64(minVal))
-		}
-
-	default:
-		if v, ok := value.(typedef.Validatable); ok {
-			if err := v.Err(); err != nil {
-				return err
-			}
-		}
-
-		s := fmt.Sprintf("%v", value)
-		if f, err := strconv.ParseFloat(s, 64); err == nil {
-			if f < minVal {
-				return faults.ErrBelowMinimum.Render(int64(minVal))
-			}
-		} else {
-			return faults.ErrInvalidNumericFormat
-		}
-	}
-
-	return nil
-}
-
-func maxRule(value any, param string) error {
-	maxVal, err := strconv.ParseFloat(param, 64)
-	if err != nil {
-		return faults.ErrInvalidParameter.Render(param)
-	}
-
-	val := reflect.ValueOf(value)
-
-	switch val.Kind() {
-	case reflect.String:
-		if f, err := strconv.ParseFloat(val.String(), 64); err == nil {
-			if f > maxVal {
-				return faults.ErrAboveMaximum.Render(int64(maxVal))
-			}
-		} else {
-			return faults.ErrInvalidNumericFormat
-		}
-
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if float64(val.Int()) > maxVal {
-			return faults.ErrAboveMaximum.Render(int64(maxVal))
-		}
-
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if float64(val.Uint()) > maxVal {
-			return faults.ErrAboveMaximum.Render(int64(maxVal))
-		}
-
-	case reflect.Float32, reflect.Float64:
-		if val.Float() > maxVal {
-			return faults.ErrAboveMaximum.Render(int64(maxVal))
-		}
-
-	default:
-		if errVal, ok := value.(typedef.Validatable); ok {
-			if err := errVal.Err(); err != nil {
-				return err
-			}
-		}
-
-		s := fmt.Sprintf("%v", value)
-		if f, err := strconv.ParseFloat(s, 64); err == nil {
-			if f > maxVal {
-				return faults.ErrAboveMaximum.Render(int64(maxVal))
-			}
-		} else {
-			return faults.ErrInvalidNumericFormat
-		}
-	}
-
-	return nil
-}
-
-func minlenRule(value any, param string) error {
-	min, _ := strconv.Atoi(param)
-	if s, ok := value.(string); ok && len(s) < min {
-		return faults.ErrLengthBelowMinimum.Render(min)
-	}
-	return nil
-}
-
-func maxlenRule(value any, param string) error {
-	max, _ := strconv.Atoi(param)
-	if s, ok := value.(string); ok && len(s) > max {
-		return faults.ErrLengthAboveMaximum.Render(max)
-	}
-	return nil
-}
-
 func emailRule(value any, _ string) error {
 	if s, ok := value.(string); ok && !emailRe.MatchString(s) {
 		return faults.ErrMustBeEmail
@@ -185,47 +69,65 @@ func alphabetRule(value any, _ string) error {
 	return nil
 }
 
-func dateRule(value any, _ string) error {
-	var s string
+// dateRule accepts strings in the layout given as parameter, e.g.
+// `date=02/01/2006` or `date=unix`, or else in one of the engine's date
+// layouts. See typedef.ParseTime for the layout names.
+func dateRule(fl FieldLevel) error {
+	layouts := fl.engineOrDefault().dateLayouts
+	if fl.Param() != "" {
+		layouts = []string{fl.Param()}
+	}
 
-	switch v := value.(type) {
-	case string:
-		s = v
-	case fmt.Stringer:
-		s = v.String()
-	default:
-		return faults.ErrInvalidDateFormat
+	if s, ok := timeString(fl.Value()); ok && matchLayouts(layouts, s) {
+		return nil
 	}
 
-	const format = "2006-01-02"
+	return dateLayoutError(layouts)
+}
 
-	if _, err := time.Parse(format, s); err != nil {
-		return faults.ErrInvalidDateFormat
+// datetimeRule is the datetime counterpart of dateRule.
+func datetimeRule(fl FieldLevel) error {
+	layouts := fl.engineOrDefault().datetimeLayouts
+	if fl.Param() != "" {
+		layouts = []string{fl.Param()}
 	}
 
-	return nil
+	if s, ok := timeString(fl.Value()); ok && matchLayouts(layouts, s) {
+		return nil
+	}
+
+	if slices.Equal(layouts, []string{defaultDatetimeLayout}) {
+		return faults.ErrInvalidDatetimeFormat
+	}
+	return faults.ErrInvalidDatetimeLayout.Render(strings.Join(layouts, ", "))
 }
 
-func datetimeRule(value any, _ string) error {
-	var s string
+// dateLayoutError reports a string matching none of the date layouts,
+// naming them unless they are the default yyyy-mm-dd.
+func dateLayoutError(layouts []string) error {
+	if slices.Equal(layouts, []string{defaultDateLayout}) {
+		return faults.ErrInvalidDateFormat
+	}
+	return faults.ErrInvalidDateLayout.Render(strings.Join(layouts, ", "))
+}
 
+func timeString(value any) (string, bool) {
 	switch v := value.(type) {
 	case string:
-		s = v
+		return v, true
 	case fmt.Stringer:
-		s = v.String()
-	default:
-		return faults.ErrInvalidDatetimeFormat
+		return v.String(), true
 	}
+	return asString(value)
+}
 
-	layout := "2006-01-02 15:04:05"
-
-	_, err := time.Parse(layout, s)
-	if err != nil {
-		return faults.ErrInvalidDatetimeFormat
+func matchLayouts(layouts []string, s string) bool {
+	for _, layout := range layouts {
+		if _, err := typedef.ParseTime(layout, s); err == nil {
+			return true
+		}
 	}
-	return nil
-
+	return false
 }
 
 func nameRule(value any, _ string) error {
@@ -257,7 +159,7 @@ func oneOfRule(value any, param string) error {
 }
 
 func splitByPipe(param string) []string {
-	raw := regexp.MustCompile(`\s*\|\s*`).Split(param, -1)
+	raw := pipeRe.Split(param, -1)
 	out := make([]string, 0, len(raw))
 	for _, v := range raw {
 		if v != "" {
@@ -0,0 +1,213 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/godev90/validator/faults"
+)
+
+// ibanLengths are the lengths of IBANs per country, from the SWIFT IBAN
+// registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20,
+	"LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27,
+	"MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28,
+	"PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31,
+	"SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// creditCardRule accepts card numbers of 12 to 19 digits passing the Luhn
+// check. Spaces and dashes between digit groups are ignored.
+func creditCardRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeCreditCard
+	}
+
+	digits := stripSeparators(s, " -")
+	if len(digits) < 12 || len(digits) > 19 || !luhnValid(digits) {
+		return faults.ErrMustBeCreditCard
+	}
+	return nil
+}
+
+// ibanRule accepts IBANs of the length registered for their country whose
+// mod-97 checksum is 1. Spaces are ignored.
+func ibanRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeIBAN
+	}
+
+	iban := strings.ToUpper(stripSeparators(s, " "))
+	if len(iban) < 4 || ibanLengths[iban[:2]] != len(iban) || !isDigits(iban[2:4]) {
+		return faults.ErrMustBeIBAN
+	}
+
+	// The country code and check digits move to the end, and letters
+	// count as 10 to 35.
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return faults.ErrMustBeIBAN
+		}
+	}
+
+	if remainder != 1 {
+		return faults.ErrMustBeIBAN
+	}
+	return nil
+}
+
+// isbnRule accepts ISBN-10 and ISBN-13 numbers with a valid check digit.
+// Hyphens and spaces are ignored.
+func isbnRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeISBN
+	}
+
+	isbn := stripSeparators(s, " -")
+	if !isISBN10(isbn) && !isISBN13(isbn) {
+		return faults.ErrMustBeISBN
+	}
+	return nil
+}
+
+func isbn10Rule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || !isISBN10(stripSeparators(s, " -")) {
+		return faults.ErrMustBeISBN
+	}
+	return nil
+}
+
+func isbn13Rule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || !isISBN13(stripSeparators(s, " -")) {
+		return faults.ErrMustBeISBN
+	}
+	return nil
+}
+
+// ean13Rule accepts 13-digit EAN barcodes with a valid check digit.
+func ean13Rule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 13 || !gtinValid(s) {
+		return faults.ErrMustBeEAN
+	}
+	return nil
+}
+
+// upcRule accepts 12-digit UPC-A barcodes with a valid check digit.
+func upcRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 12 || !gtinValid(s) {
+		return faults.ErrMustBeUPC
+	}
+	return nil
+}
+
+// bicRule accepts SWIFT/BIC codes: a four-letter institution code, a
+// two-letter country code, a two-character location code and an optional
+// three-character branch code.
+func bicRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 8 && len(s) != 11 {
+		return faults.ErrMustBeBIC
+	}
+
+	bic := strings.ToUpper(s)
+	if leadingLetters(bic) < 6 {
+		return faults.ErrMustBeBIC
+	}
+
+	for i := 6; i < len(bic); i++ {
+		c := bic[i]
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return faults.ErrMustBeBIC
+		}
+	}
+	return nil
+}
+
+func isISBN10(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := s[i]
+
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * d
+	}
+	return sum%11 == 0
+}
+
+func isISBN13(s string) bool {
+	return len(s) == 13 && (strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979")) && gtinValid(s)
+}
+
+// gtinValid reports whether the last digit of s is the GTIN check digit
+// of the others, as used by EAN and UPC barcodes and ISBN-13.
+func gtinValid(s string) bool {
+	if !isDigits(s) {
+		return false
+	}
+
+	sum := 0
+	for i := len(s) - 2; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(s[len(s)-1]-'0')
+}
+
+// luhnValid reports whether the last digit of s is the Luhn check digit
+// of the others.
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
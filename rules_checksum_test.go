@@ -0,0 +1,167 @@
+package validator
+
+import "testing"
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		s     string
+		valid bool
+	}{
+		{"79927398713", true},
+		{"79927398710", false},
+		{"0", true},
+		{"18", true},
+		{"4111111111111111", true},
+		{"4111111111111112", false},
+		{"7992739871a", false},
+	}
+
+	for _, tt := range tests {
+		if got := luhnValid(tt.s); got != tt.valid {
+			t.Errorf("luhnValid(%q) = %v, want %v", tt.s, got, tt.valid)
+		}
+	}
+}
+
+func TestCreditCardRule(t *testing.T) {
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{"4111111111111111", true},
+		{"4111 1111 1111 1111", true},
+		{"4111-1111-1111-1111", true},
+		{"5500000000000004", true},
+		{"378282246310005", true}, // 15-digit Amex
+		{"4111111111111112", false},
+		{"79927398713", false}, // passes Luhn, but too short
+		{"41111111111111111113", false},
+		{"4111.1111.1111.1111", false},
+		{"", false},
+		{4111111111111111, false},
+	}
+
+	for _, tt := range tests {
+		if err := creditCardRule(tt.value, ""); (err == nil) != tt.valid {
+			t.Errorf("credit_card %v: error = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
+
+func TestIBANRule(t *testing.T) {
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{"GB82WEST12345698765432", true},
+		{"GB82 WEST 1234 5698 7654 32", true},
+		{"gb82west12345698765432", true},
+		{"DE89370400440532013000", true},
+		{"FR1420041010050500013M02606", true},
+		{"NL91ABNA0417164300", true},
+		{"NO9386011117947", true},
+		{"GB82WEST12345698765431", false}, // checksum
+		{"GB28WEST12345698765432", false}, // check digits swapped
+		{"GB82WEST1234569876543", false},  // length
+		{"NL91ABNA04171643000", false},
+		{"XX82WEST12345698765432", false}, // unknown country
+		{"GBA2WEST12345698765432", false},
+		{"GB82WEST123456987654-2", false},
+		{"GB8", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if err := ibanRule(tt.value, ""); (err == nil) != tt.valid {
+			t.Errorf("iban %v: error = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
+
+func TestISBNRules(t *testing.T) {
+	tests := []struct {
+		value          string
+		isbn10, isbn13 bool
+	}{
+		{"0306406152", true, false},
+		{"0-306-40615-2", true, false},
+		{"080442957X", true, false},
+		{"080442957x", true, false},
+		{"0198526636", true, false},
+		{"0306406153", false, false},
+		{"X306406152", false, false},
+		{"030640615", false, false},
+		{"9780306406157", false, true},
+		{"978-0-306-40615-7", false, true},
+		{"978 0 306 40615 7", false, true},
+		{"9791090636071", false, true},
+		{"9780306406158", false, false},
+		{"9770306406158", false, false}, // valid EAN, but not an ISBN
+		{"97803064061570", false, false},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		if err := isbn10Rule(tt.value, ""); (err == nil) != tt.isbn10 {
+			t.Errorf("isbn10 %q: error = %v, want valid %v", tt.value, err, tt.isbn10)
+		}
+		if err := isbn13Rule(tt.value, ""); (err == nil) != tt.isbn13 {
+			t.Errorf("isbn13 %q: error = %v, want valid %v", tt.value, err, tt.isbn13)
+		}
+		if err := isbnRule(tt.value, ""); (err == nil) != (tt.isbn10 || tt.isbn13) {
+			t.Errorf("isbn %q: error = %v, want valid %v", tt.value, err, tt.isbn10 || tt.isbn13)
+		}
+	}
+}
+
+func TestGTINRules(t *testing.T) {
+	tests := []struct {
+		value      string
+		ean13, upc bool
+	}{
+		{"4006381333931", true, false},
+		{"5901234123457", true, false},
+		{"9780306406157", true, false},
+		{"9770306406158", true, false},
+		{"4006381333932", false, false},
+		{"036000291452", false, true},
+		{"036000291453", false, false},
+		{"0036000291452", true, false}, // UPC-A as EAN-13
+		{"400638133393", false, false},
+		{"400638133393A", false, false},
+		{"4006-381333931", false, false},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		if err := ean13Rule(tt.value, ""); (err == nil) != tt.ean13 {
+			t.Errorf("ean13 %q: error = %v, want valid %v", tt.value, err, tt.ean13)
+		}
+		if err := upcRule(tt.value, ""); (err == nil) != tt.upc {
+			t.Errorf("upc %q: error = %v, want valid %v", tt.value, err, tt.upc)
+		}
+	}
+}
+
+func TestBICRule(t *testing.T) {
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"bmriidja", true},
+		{"CENAIDJA", true},
+		{"DEUT1EFF", false},
+		{"DEUTDEF", false},
+		{"DEUTDEFF50", false},
+		{"DEUTDEFF-00", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if err := bicRule(tt.value, ""); (err == nil) != tt.valid {
+			t.Errorf("bic %v: error = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/godev90/validator/faults"
+)
+
+// requiredIfRule requires the value when every `Field value` pair of the
+// parameter matches, e.g. `required_if=Type company`.
+func requiredIfRule(fl FieldLevel) error {
+	if hasValue(fl.Field()) {
+		return nil
+	}
+
+	fields, values, match, err := matchFields(fl)
+	if err != nil {
+		return err
+	}
+
+	if match {
+		return faults.ErrRequiredIf.Render(fields, values)
+	}
+	return nil
+}
+
+// requiredUnlessRule requires the value unless every `Field value` pair of
+// the parameter matches.
+func requiredUnlessRule(fl FieldLevel) error {
+	if hasValue(fl.Field()) {
+		return nil
+	}
+
+	fields, values, match, err := matchFields(fl)
+	if err != nil {
+		return err
+	}
+
+	if !match {
+		return faults.ErrRequiredUnless.Render(fields, values)
+	}
+	return nil
+}
+
+// requiredWithRule requires the value when any of the space separated
+// fields of the parameter has a value.
+func requiredWithRule(fl FieldLevel) error {
+	if hasValue(fl.Field()) {
+		return nil
+	}
+
+	for _, name := range strings.Fields(fl.Param()) {
+		other, ok := fl.Lookup(name)
+		if !ok {
+			return faults.ErrInvalidParameter.Render(fl.Param())
+		}
+
+		if hasValue(other) {
+			return faults.ErrRequiredWith.Render(name)
+		}
+	}
+	return nil
+}
+
+// requiredWithoutRule requires the value when any of the space separated
+// fields of the parameter is empty.
+func requiredWithoutRule(fl FieldLevel) error {
+	if hasValue(fl.Field()) {
+		return nil
+	}
+
+	for _, name := range strings.Fields(fl.Param()) {
+		other, ok := fl.Lookup(name)
+		if !ok {
+			return faults.ErrInvalidParameter.Render(fl.Param())
+		}
+
+		if !hasValue(other) {
+			return faults.ErrRequiredWithout.Render(name)
+		}
+	}
+	return nil
+}
+
+// excludedIfRule requires the value to be empty when every `Field value`
+// pair of the parameter matches.
+func excludedIfRule(fl FieldLevel) error {
+	if !hasValue(fl.Field()) {
+		return nil
+	}
+
+	fields, values, match, err := matchFields(fl)
+	if err != nil {
+		return err
+	}
+
+	if match {
+		return faults.ErrExcludedIf.Render(fields, values)
+	}
+	return nil
+}
+
+// matchFields reports whether all `Field value` pairs of the parameter
+// match their sibling fields. It also returns the field names and values
+// joined for error messages.
+func matchFields(fl FieldLevel) (fields, values string, match bool, err error) {
+	tokens := strings.Fields(fl.Param())
+	if len(tokens) == 0 || len(tokens)%2 != 0 {
+		return "", "", false, faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	names := make([]string, 0, len(tokens)/2)
+	expected := make([]string, 0, len(tokens)/2)
+	match = true
+
+	for i := 0; i < len(tokens); i += 2 {
+		other, ok := fl.Lookup(tokens[i])
+		if !ok {
+			return "", "", false, faults.ErrInvalidParameter.Render(fl.Param())
+		}
+
+		names = append(names, tokens[i])
+		expected = append(expected, tokens[i+1])
+
+		other = indirect(other)
+		if !other.IsValid() || fmt.Sprintf("%v", other.Interface()) != tokens[i+1] {
+			match = false
+		}
+	}
+
+	return strings.Join(names, ", "), strings.Join(expected, ", "), match, nil
+}
+
+// hasValue reports whether val holds something other than nil or its zero
+// value, consistently with the required rule.
+func hasValue(val reflect.Value) bool {
+	val = indirect(val)
+	if !val.IsValid() {
+		return false
+	}
+	return !isZero(val)
+}
@@ -0,0 +1,224 @@
+package validator
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/godev90/validator/faults"
+)
+
+type (
+	timeValue interface {
+		Time() time.Time
+	}
+
+	int64Value interface {
+		Int64() int64
+	}
+
+	float64Value interface {
+		Float64() float64
+	}
+)
+
+func eqFieldRule(fl FieldLevel) error {
+	other, label, err := crossField(fl)
+	if err != nil {
+		return err
+	}
+
+	if !equalValues(fl.Field(), other) {
+		return faults.ErrMustEqualField.Render(label)
+	}
+	return nil
+}
+
+func neFieldRule(fl FieldLevel) error {
+	other, label, err := crossField(fl)
+	if err != nil {
+		return err
+	}
+
+	if equalValues(fl.Field(), other) {
+		return faults.ErrMustNotEqualField.Render(label)
+	}
+	return nil
+}
+
+func gtFieldRule(fl FieldLevel) error {
+	return compareField(fl, func(c int) bool { return c > 0 }, faults.ErrMustBeGreaterThanField)
+}
+
+func gteFieldRule(fl FieldLevel) error {
+	return compareField(fl, func(c int) bool { return c >= 0 }, faults.ErrMustBeGreaterOrEqualField)
+}
+
+func ltFieldRule(fl FieldLevel) error {
+	return compareField(fl, func(c int) bool { return c < 0 }, faults.ErrMustBeLessThanField)
+}
+
+func lteFieldRule(fl FieldLevel) error {
+	return compareField(fl, func(c int) bool { return c <= 0 }, faults.ErrMustBeLessOrEqualField)
+}
+
+// compareField orders the value against the field named by the parameter.
+// A nil other field is left to the presence rules.
+func compareField(fl FieldLevel, accept func(int) bool, fault faults.Error) error {
+	other, label, err := crossField(fl)
+	if err != nil {
+		return err
+	}
+
+	other = indirect(other)
+	if !other.IsValid() {
+		return nil
+	}
+
+	c, ok := compareValues(fl.Field(), other)
+	if !ok {
+		return faults.ErrTypeMismatch
+	}
+
+	if !accept(c) {
+		return fault.Render(label)
+	}
+	return nil
+}
+
+// crossField returns the value to compare with and how to refer to it in
+// error messages: the field named by the parameter, or the value given to
+// ValidateVarWithValue.
+func crossField(fl FieldLevel) (reflect.Value, string, error) {
+	if fl.other.IsValid() {
+		return fl.other, fmt.Sprintf("%v", fl.other.Interface()), nil
+	}
+
+	name := strings.TrimSpace(fl.Param())
+	if name == "" {
+		return reflect.Value{}, "", faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	other, ok := fl.Lookup(name)
+	if !ok {
+		return reflect.Value{}, "", faults.ErrInvalidParameter.Render(fl.Param())
+	}
+	return other, name, nil
+}
+
+// indirect dereferences pointers and interfaces, returning an invalid value
+// for nil.
+func indirect(val reflect.Value) reflect.Value {
+	for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return reflect.Value{}
+		}
+		val = val.Elem()
+	}
+	return val
+}
+
+func equalValues(a, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+
+	if c, ok := compareValues(a, b); ok {
+		return c == 0
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// compareValues orders two numbers, strings, times, typedef.Date or
+// typedef.Datetime values. It reports false when they cannot be compared.
+func compareValues(a, b reflect.Value) (int, bool) {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		return 0, false
+	}
+
+	if ta, ok := asTime(a); ok {
+		if tb, ok := asTime(b); ok {
+			return ta.Compare(tb), true
+		}
+		return 0, false
+	}
+
+	switch {
+	case isIntKind(a.Kind()) && isIntKind(b.Kind()):
+		return cmp.Compare(a.Int(), b.Int()), true
+
+	case isUintKind(a.Kind()) && isUintKind(b.Kind()):
+		return cmp.Compare(a.Uint(), b.Uint()), true
+
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		return strings.Compare(a.String(), b.String()), true
+
+	case a.Kind() == reflect.Bool && b.Kind() == reflect.Bool:
+		return cmp.Compare(boolRank(a.Bool()), boolRank(b.Bool())), true
+	}
+
+	fa, okA := asFloat(a)
+	fb, okB := asFloat(b)
+	if okA && okB {
+		return cmp.Compare(fa, fb), true
+	}
+
+	return 0, false
+}
+
+func asTime(val reflect.Value) (time.Time, bool) {
+	if !val.CanInterface() {
+		return time.Time{}, false
+	}
+
+	switch v := val.Interface().(type) {
+	case time.Time:
+		return v, true
+	case timeValue:
+		return v.Time(), true
+	}
+	return time.Time{}, false
+}
+
+func asFloat(val reflect.Value) (float64, bool) {
+	switch {
+	case isIntKind(val.Kind()):
+		return float64(val.Int()), true
+	case isUintKind(val.Kind()):
+		return float64(val.Uint()), true
+	case val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64:
+		return val.Float(), true
+	}
+
+	if !val.CanInterface() {
+		return 0, false
+	}
+
+	switch v := val.Interface().(type) {
+	case int64Value:
+		return float64(v.Int64()), true
+	case float64Value:
+		return v.Float64(), true
+	}
+	return 0, false
+}
+
+func isIntKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isUintKind(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uintptr
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
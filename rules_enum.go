@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/godev90/validator/faults"
+)
+
+// enumSet holds the values of an enum registered with RegisterEnum, keyed
+// by value and by their string form, which is how values of other types,
+// such as strings decoded from JSON, are matched.
+type enumSet struct {
+	typ    reflect.Type
+	values map[any]bool
+	names  map[string]bool
+	folded map[string]bool
+	list   string
+}
+
+// RegisterEnum registers the values of an enum on the default engine, to
+// be referenced in tags as `enum=name`, or `enum_ci=name` to compare
+// strings case-insensitively.
+//
+//	validator.RegisterEnum("OrderStatus", StatusPending, StatusPaid, StatusShipped)
+func RegisterEnum[T comparable](name string, values ...T) {
+	RegisterEnumOn(std, name, values...)
+}
+
+// RegisterEnumOn registers the values of an enum on e, see RegisterEnum.
+func RegisterEnumOn[T comparable](e *Engine, name string, values ...T) {
+	set := &enumSet{
+		typ:    reflect.TypeFor[T](),
+		values: make(map[any]bool, len(values)),
+		names:  make(map[string]bool, len(values)),
+		folded: make(map[string]bool, len(values)),
+	}
+
+	list := make([]string, len(values))
+	for i, value := range values {
+		list[i] = fmt.Sprint(value)
+		set.values[value] = true
+		set.names[list[i]] = true
+		set.folded[strings.ToLower(list[i])] = true
+	}
+	set.list = strings.Join(list, ", ")
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.enums == nil {
+		e.enums = make(map[string]*enumSet)
+	}
+	e.enums[name] = set
+	e.invalidatePlans()
+}
+
+func (e *Engine) lookupEnum(name string) (*enumSet, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	set, ok := e.enums[name]
+	return set, ok
+}
+
+// enumRule accepts the values of the enum given as parameter. Without a
+// parameter, it accepts values whose IsValid method returns true.
+func enumRule(fl FieldLevel) error {
+	return checkEnum(fl, false)
+}
+
+// enumCIRule is enumRule comparing the string forms of values
+// case-insensitively.
+func enumCIRule(fl FieldLevel) error {
+	return checkEnum(fl, true)
+}
+
+func checkEnum(fl FieldLevel, fold bool) error {
+	value := fl.Value()
+
+	if fl.Param() == "" {
+		// Field keeps the value addressable, for IsValid methods with
+		// pointer receivers.
+		if field := fl.Field(); field.IsValid() {
+			if v, ok := asInterface(field, enumType); ok && v.(Enum).IsValid() {
+				return nil
+			}
+		}
+		return faults.ErrInvalidEnum
+	}
+
+	set, ok := fl.engineOrDefault().lookupEnum(fl.Param())
+	if !ok {
+		return faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	if set.contains(value, fold) {
+		return nil
+	}
+	return faults.ErrMustBeOneOf.Render(set.list)
+}
+
+func (set *enumSet) contains(value any, fold bool) bool {
+	if reflect.TypeOf(value) == set.typ && set.values[value] {
+		return true
+	}
+
+	name := fmt.Sprint(value)
+	if fold {
+		return set.folded[strings.ToLower(name)]
+	}
+	return set.names[name]
+}
@@ -0,0 +1,221 @@
+package validator
+
+import (
+	"strings"
+	"time"
+
+	"github.com/godev90/validator/faults"
+)
+
+var (
+	// nikProvinces are the province codes in the first two digits of a
+	// NIK, as assigned by Kemendagri.
+	nikProvinces = map[string]bool{
+		"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+		"21": true,
+		"31": true, "32": true, "33": true, "34": true, "35": true, "36": true,
+		"51": true, "52": true, "53": true,
+		"61": true, "62": true, "63": true, "64": true, "65": true,
+		"71": true, "72": true, "73": true, "74": true, "75": true, "76": true,
+		"81": true, "82": true,
+		"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true,
+	}
+
+	// bankAccountLengths are the lengths of account numbers per bank,
+	// selected with e.g. `bank_account=bca`.
+	bankAccountLengths = map[string]int{
+		"bca":     10,
+		"bni":     10,
+		"bri":     15,
+		"bsi":     10,
+		"btn":     16,
+		"cimb":    13,
+		"danamon": 10,
+		"mandiri": 13,
+		"permata": 10,
+	}
+
+	// mobilePrefixes are the second digits of Indonesian mobile numbers
+	// after the leading 8.
+	mobilePrefixes = "123589"
+)
+
+// nikRule accepts a Nomor Induk Kependudukan: 16 digits holding the
+// province, regency and district codes, the birth date, with 40 added to
+// the day for women, and a serial number.
+func nikRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || !isNIK(s) {
+		return faults.ErrMustBeNIK
+	}
+	return nil
+}
+
+// npwpRule accepts a Nomor Pokok Wajib Pajak, either the 15-digit form,
+// optionally written as 01.234.567.4-901.000, whose ninth digit is a Luhn
+// check digit, or the 16-digit form, which is a NIK or a 15-digit NPWP
+// prefixed with 0.
+func npwpRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeNPWP
+	}
+
+	digits := stripSeparators(s, ".-")
+	switch {
+	case len(digits) == 15 && isDigits(digits) && luhnValid(digits[:9]):
+		return nil
+	case len(digits) == 16 && digits[0] == '0' && isDigits(digits) && luhnValid(digits[1:10]):
+		return nil
+	case len(digits) == 16 && isNIK(digits):
+		return nil
+	}
+	return faults.ErrMustBeNPWP
+}
+
+// phoneIDRule accepts Indonesian mobile numbers starting with +62, 62 or
+// 0, e.g. +62 812-3456-7890 or 081234567890.
+func phoneIDRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBePhoneID
+	}
+
+	number := stripSeparators(s, " -")
+	switch {
+	case strings.HasPrefix(number, "+62"):
+		number = number[3:]
+	case strings.HasPrefix(number, "62"):
+		number = number[2:]
+	case strings.HasPrefix(number, "0"):
+		number = number[1:]
+	default:
+		return faults.ErrMustBePhoneID
+	}
+
+	if len(number) < 9 || len(number) > 12 || !isDigits(number) ||
+		number[0] != '8' || !strings.ContainsRune(mobilePrefixes, rune(number[1])) {
+		return faults.ErrMustBePhoneID
+	}
+	return nil
+}
+
+// postcodeIDRule accepts five-digit Indonesian postal codes.
+func postcodeIDRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 5 || !isDigits(s) || s[0] == '0' {
+		return faults.ErrMustBePostcodeID
+	}
+	return nil
+}
+
+// bankAccountRule accepts account numbers of 10 to 16 digits, or of the
+// length used by the bank given as parameter.
+func bankAccountRule(value any, param string) error {
+	s, ok := asString(value)
+	if !ok || !isDigits(s) {
+		return faults.ErrMustBeBankAccount
+	}
+
+	if param == "" {
+		if len(s) < 10 || len(s) > 16 {
+			return faults.ErrMustBeBankAccount
+		}
+		return nil
+	}
+
+	length, ok := bankAccountLengths[strings.ToLower(param)]
+	if !ok {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	if len(s) != length {
+		return faults.ErrMustBeBankAccount
+	}
+	return nil
+}
+
+// plateIDRule accepts vehicle registration plates such as B 1234 ABC: a
+// one or two letter region code, up to four digits and up to three
+// letters. Spaces are optional.
+func plateIDRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBePlateID
+	}
+
+	plate := strings.ToUpper(stripSeparators(s, " "))
+	region := leadingLetters(plate)
+	plate = plate[region:]
+
+	digits := 0
+	for digits < len(plate) && plate[digits] >= '0' && plate[digits] <= '9' {
+		digits++
+	}
+	suffix := leadingLetters(plate[digits:])
+
+	if region < 1 || region > 2 || digits < 1 || digits > 4 || plate[0] == '0' ||
+		suffix > 3 || digits+suffix != len(plate) {
+		return faults.ErrMustBePlateID
+	}
+	return nil
+}
+
+func isNIK(s string) bool {
+	if len(s) != 16 || !isDigits(s) || !nikProvinces[s[:2]] {
+		return false
+	}
+
+	if s[2:4] == "00" || s[4:6] == "00" || s[12:] == "0000" {
+		return false
+	}
+
+	day := atoi2(s[6:8])
+	if day > 40 {
+		day -= 40
+	}
+	month := atoi2(s[8:10])
+
+	// The year has no century; 2000 is used since it is a leap year, so
+	// 29 February is accepted on every year divisible by four.
+	year := 2000 + atoi2(s[10:12])
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	return day <= time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func atoi2(s string) int {
+	return int(s[0]-'0')*10 + int(s[1]-'0')
+}
+
+func leadingLetters(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= 'A' && s[n] <= 'Z' {
+		n++
+	}
+	return n
+}
+
+// stripSeparators removes the characters in seps from s.
+func stripSeparators(s, seps string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(seps, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,69 @@
+package validator
+
+import "testing"
+
+func TestNIKRule(t *testing.T) {
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{"3201011508900001", true},  // man born 15 August 1990
+		{"3201015508900001", true},  // woman, day + 40
+		{"3174052902000123", true},  // 29 February 2000
+		{"9601013112990001", true},  // 31 December, Papua
+		{"3201012902010001", false}, // 29 February 2001
+		{"3201013104900001", false}, // 31 April
+		{"3201017102900001", false}, // 31 February for a woman
+		{"3201010008900001", false}, // day 0
+		{"3201014008900001", false}, // day 0 for a woman
+		{"3201011513900001", false}, // month 13
+		{"3201011500900001", false}, // month 0
+		{"9901011508900001", false}, // unknown province
+		{"3200011508900001", false}, // regency 00
+		{"3201001508900001", false}, // district 00
+		{"3201011508900000", false}, // serial 0000
+		{"320101150890001", false},  // 15 digits
+		{"32010115089000011", false},
+		{"3201-0115089000 1", false},
+		{"320101150890000A", false},
+		{"", false},
+		{3201011508900001, false},
+	}
+
+	for _, tt := range tests {
+		if err := nikRule(tt.value, ""); (err == nil) != tt.valid {
+			t.Errorf("nik %v: error = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
+
+func TestNPWPRule(t *testing.T) {
+	tests := []struct {
+		value any
+		valid bool
+	}{
+		{"012345674901000", true},
+		{"01.234.567.4-901.000", true},
+		{"09.123.456.7-012.000", true},
+		{"02.123.456.2-123.456", true},
+		{"0012345674901000", true}, // 15-digit NPWP prefixed with 0
+		{"3201011508900001", true}, // NIK
+		{"012345678901000", false}, // check digit
+		{"01.234.567.8-901.000", false},
+		{"0012345678901000", false},
+		{"1012345674901000", false}, // neither prefixed nor a NIK
+		{"3201013104900001", false}, // NIK with 31 April
+		{"01234567490100", false},   // 14 digits
+		{"01234567490100000", false},
+		{"01.234.567.4/901.000", false},
+		{"01234567490100A", false},
+		{"", false},
+		{12345674901000, false},
+	}
+
+	for _, tt := range tests {
+		if err := npwpRule(tt.value, ""); (err == nil) != tt.valid {
+			t.Errorf("npwp %v: error = %v, want valid %v", tt.value, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/godev90/validator/faults"
+)
+
+// lengthCheck describes one of the length rules: how the length is counted
+// and when it is acceptable.
+type lengthCheck struct {
+	graphemes bool
+	accept    func(n, limit int) bool
+	chars     faults.Error
+	items     faults.Error
+}
+
+var (
+	atLeast = func(n, limit int) bool { return n >= limit }
+	atMost  = func(n, limit int) bool { return n <= limit }
+	exactly = func(n, limit int) bool { return n == limit }
+)
+
+func minlenRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		accept: atLeast,
+		chars:  faults.ErrLengthBelowMinimum,
+		items:  faults.ErrItemsBelowMinimum,
+	})
+}
+
+func maxlenRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		accept: atMost,
+		chars:  faults.ErrLengthAboveMaximum,
+		items:  faults.ErrItemsAboveMaximum,
+	})
+}
+
+func lenRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		accept: exactly,
+		chars:  faults.ErrLengthNotExact,
+		items:  faults.ErrItemsNotExact,
+	})
+}
+
+func minGraphemesRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		graphemes: true,
+		accept:    atLeast,
+		chars:     faults.ErrLengthBelowMinimum,
+		items:     faults.ErrItemsBelowMinimum,
+	})
+}
+
+func maxGraphemesRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		graphemes: true,
+		accept:    atMost,
+		chars:     faults.ErrLengthAboveMaximum,
+		items:     faults.ErrItemsAboveMaximum,
+	})
+}
+
+func graphemesRule(value any, param string) error {
+	return checkLength(value, param, lengthCheck{
+		graphemes: true,
+		accept:    exactly,
+		chars:     faults.ErrLengthNotExact,
+		items:     faults.ErrItemsNotExact,
+	})
+}
+
+func checkLength(value any, param string, check lengthCheck) error {
+	limit, err := strconv.Atoi(param)
+	if err != nil || limit < 0 {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	// Like the original minlen and maxlen, values without a length, such
+	// as numbers, are left to other rules.
+	n, items, ok := valueLength(value, check.graphemes)
+	if !ok {
+		return nil
+	}
+
+	if check.accept(n, limit) {
+		return nil
+	}
+
+	if items {
+		return check.items.Render(limit)
+	}
+	return check.chars.Render(limit)
+}
+
+// valueLength counts the characters of strings and fmt.Stringer values
+// such as the typedef types, or the elements of slices, arrays and maps,
+// which are reported as items. Characters are runes, or approximate
+// grapheme clusters when graphemes is set.
+func valueLength(value any, graphemes bool) (n int, items bool, ok bool) {
+	var s string
+
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case fmt.Stringer:
+		s = v.String()
+	default:
+		val := reflect.ValueOf(value)
+		switch val.Kind() {
+		case reflect.String:
+			s = val.String()
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return val.Len(), true, true
+		default:
+			return 0, false, false
+		}
+	}
+
+	if graphemes {
+		return graphemeCount(s), false, true
+	}
+	return utf8.RuneCountInString(s), false, true
+}
+
+// graphemeCount approximates the number of user-perceived characters of s:
+// combining marks, variation selectors and emoji skin tone modifiers extend
+// the previous character, a zero width joiner glues emoji sequences, and
+// regional indicators pair up into flags.
+func graphemeCount(s string) int {
+	var (
+		n          int
+		joined     bool
+		indicators int
+	)
+
+	for _, r := range s {
+		switch {
+		case r == '\u200d': // zero width joiner
+			joined = true
+			continue
+
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc),
+			r >= 0xfe00 && r <= 0xfe0f,   // variation selectors
+			r >= 0x1f3fb && r <= 0x1f3ff: // emoji modifiers
+			if n == 0 {
+				n++
+			}
+			continue
+
+		case r >= 0x1f1e6 && r <= 0x1f1ff: // regional indicators
+			indicators++
+			if indicators%2 == 0 {
+				continue
+			}
+
+		default:
+			indicators = 0
+		}
+
+		if joined {
+			joined = false
+			continue
+		}
+		n++
+	}
+
+	return n
+}
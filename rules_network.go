@@ -0,0 +1,227 @@
+package validator
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/godev90/validator/faults"
+)
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// urlRule accepts absolute URLs with a host. An optional parameter lists
+// the allowed schemes, e.g. `url=https` or `url=http|https`.
+func urlRule(value any, param string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeURL
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return faults.ErrMustBeURL
+	}
+
+	if param == "" {
+		return nil
+	}
+
+	for _, scheme := range splitByPipe(param) {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return nil
+		}
+	}
+	return faults.ErrMustBeURL
+}
+
+// uriRule accepts any absolute URI, e.g. `mailto:someone@example.com`.
+func uriRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeURI
+	}
+
+	if u, err := url.Parse(s); err != nil || u.Scheme == "" {
+		return faults.ErrMustBeURI
+	}
+	return nil
+}
+
+// hostnameRule accepts RFC 1123 host names.
+func hostnameRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || !isHostname(s) {
+		return faults.ErrMustBeHostname
+	}
+	return nil
+}
+
+func ipRule(value any, _ string) error {
+	if _, ok := parseAddr(value); !ok {
+		return faults.ErrMustBeIP
+	}
+	return nil
+}
+
+func ipv4Rule(value any, _ string) error {
+	if addr, ok := parseAddr(value); !ok || !addr.Is4() {
+		return faults.ErrMustBeIPv4
+	}
+	return nil
+}
+
+func ipv6Rule(value any, _ string) error {
+	if addr, ok := parseAddr(value); !ok || !addr.Is6() {
+		return faults.ErrMustBeIPv6
+	}
+	return nil
+}
+
+func cidrRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeCIDR
+	}
+
+	if _, err := netip.ParsePrefix(s); err != nil {
+		return faults.ErrMustBeCIDR
+	}
+	return nil
+}
+
+func macRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrMustBeMAC
+	}
+
+	if _, err := net.ParseMAC(s); err != nil {
+		return faults.ErrMustBeMAC
+	}
+	return nil
+}
+
+// portRule accepts TCP/UDP ports from 1 to 65535, as numbers or strings.
+func portRule(value any, _ string) error {
+	var port int64
+
+	if s, ok := asString(value); ok {
+		p, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return faults.ErrMustBePort
+		}
+		port = p
+	} else {
+		val := reflect.ValueOf(value)
+		switch {
+		case isIntKind(val.Kind()):
+			port = val.Int()
+		case isUintKind(val.Kind()) && val.Uint() <= 65535:
+			port = int64(val.Uint())
+		default:
+			return faults.ErrMustBePort
+		}
+	}
+
+	if port < 1 || port > 65535 {
+		return faults.ErrMustBePort
+	}
+	return nil
+}
+
+// uuidRule accepts UUIDs in their canonical 8-4-4-4-12 form. An optional
+// parameter requires a version, e.g. `uuid=4`.
+func uuidRule(value any, param string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 36 {
+		return faults.ErrMustBeUUID
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return faults.ErrMustBeUUID
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return faults.ErrMustBeUUID
+			}
+		}
+	}
+
+	if param == "" {
+		return nil
+	}
+
+	version, err := strconv.ParseUint(param, 16, 4)
+	if err != nil {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	if actual, _ := strconv.ParseUint(s[14:15], 16, 4); actual != version {
+		return faults.ErrMustBeUUID
+	}
+	return nil
+}
+
+// ulidRule accepts ULIDs: 26 Crockford base32 characters whose value fits
+// in 128 bits.
+func ulidRule(value any, _ string) error {
+	s, ok := asString(value)
+	if !ok || len(s) != 26 || s[0] > '7' {
+		return faults.ErrMustBeULID
+	}
+
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(crockfordAlphabet, upperASCII(s[i])) < 0 {
+			return faults.ErrMustBeULID
+		}
+	}
+	return nil
+}
+
+func parseAddr(value any) (netip.Addr, bool) {
+	s, ok := asString(value)
+	if !ok {
+		return netip.Addr{}, false
+	}
+
+	addr, err := netip.ParseAddr(s)
+	return addr, err == nil
+}
+
+func isHostname(s string) bool {
+	if s == "" || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
@@ -0,0 +1,445 @@
+package validator
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/godev90/validator/faults"
+	"github.com/godev90/validator/typedef"
+)
+
+// decimalRe is the syntax of numbers in strings and parameters: decimals
+// with an optional exponent, as in "12", "-0.5" or "1e9". big.Rat alone
+// would also take fractions such as "1/3" and prefixes such as "0x10".
+// Exponents are limited to four digits, as big.Rat expands them in full.
+var decimalRe = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d{1,4})?$`)
+
+// minRule accepts numbers greater than or equal to the parameter. Like the
+// other numeric rules it compares in the domain of the value: integers as
+// int64 or uint64, floats as float64 or float32, and big numbers, numeric
+// strings and typedef numbers exactly, as big.Rat. The parameter is
+// reported as written.
+func minRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c < 0 {
+		return faults.ErrBelowMinimum.Render(param)
+	}
+	return nil
+}
+
+func maxRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c > 0 {
+		return faults.ErrAboveMaximum.Render(param)
+	}
+	return nil
+}
+
+func gtRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c <= 0 {
+		return faults.ErrMustBeGreaterThan.Render(param)
+	}
+	return nil
+}
+
+func ltRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c >= 0 {
+		return faults.ErrMustBeLessThan.Render(param)
+	}
+	return nil
+}
+
+func eqRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c != 0 {
+		return faults.ErrMustEqual.Render(param)
+	}
+	return nil
+}
+
+func neRule(value any, param string) error {
+	c, err := compareNumber(value, param)
+	if err != nil {
+		return err
+	}
+
+	if c == 0 {
+		return faults.ErrMustNotEqual.Render(param)
+	}
+	return nil
+}
+
+// betweenRule accepts numbers within an inclusive range written as
+// `between=1..10`.
+func betweenRule(value any, param string) error {
+	low, high, ok := strings.Cut(param, "..")
+	if !ok {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	above, err := compareNumber(value, low)
+	if err != nil {
+		return err
+	}
+
+	below, err := compareNumber(value, high)
+	if err != nil {
+		return err
+	}
+
+	if above < 0 || below > 0 {
+		return faults.ErrMustBeBetween.Render(low, high)
+	}
+	return nil
+}
+
+// compareNumber compares value with the number written in param, returning
+// -1, 0 or +1.
+func compareNumber(value any, param string) (int, error) {
+	val := reflect.ValueOf(value)
+
+	switch {
+	case isIntKind(val.Kind()):
+		if bound, err := strconv.ParseInt(param, 10, 64); err == nil {
+			return cmp.Compare(val.Int(), bound), nil
+		}
+	case isUintKind(val.Kind()):
+		if bound, err := strconv.ParseUint(param, 10, 64); err == nil {
+			return cmp.Compare(val.Uint(), bound), nil
+		}
+	case val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64:
+		// Rounding the parameter like the value keeps `max=0.1` from
+		// rejecting 0.1, which is slightly above the exact decimal.
+		// ParseFloat alone would also take "NaN" and "Inf".
+		if !decimalRe.MatchString(param) {
+			return 0, faults.ErrInvalidParameter.Render(param)
+		}
+		if bound, err := strconv.ParseFloat(param, val.Type().Bits()); err == nil {
+			if math.IsNaN(val.Float()) {
+				return 0, faults.ErrInvalidNumericFormat
+			}
+			return cmp.Compare(val.Float(), bound), nil
+		}
+	}
+
+	bound, ok := parseDecimal(param)
+	if !ok {
+		return 0, faults.ErrInvalidParameter.Render(param)
+	}
+
+	r, err := ratOf(value)
+	if err != nil {
+		return 0, err
+	}
+	return r.Cmp(bound), nil
+}
+
+// ratOf returns the exact value of a number, a big number, a numeric
+// string or a typedef number.
+func ratOf(value any) (*big.Rat, error) {
+	if v, ok := value.(typedef.Validatable); ok {
+		if err := v.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch v := value.(type) {
+	case big.Int:
+		return new(big.Rat).SetInt(&v), nil
+	case *big.Int:
+		return new(big.Rat).SetInt(v), nil
+	case big.Rat:
+		return &v, nil
+	case *big.Rat:
+		return v, nil
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case isIntKind(val.Kind()):
+		return new(big.Rat).SetInt64(val.Int()), nil
+
+	case isUintKind(val.Kind()):
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(val.Uint())), nil
+
+	case val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64:
+		// SetFloat64 is exact, and nil for NaN and infinities.
+		if r := new(big.Rat).SetFloat64(val.Float()); r != nil {
+			return r, nil
+		}
+		return nil, faults.ErrInvalidNumericFormat
+	}
+
+	s, ok := asString(value)
+	if !ok {
+		s = fmt.Sprintf("%v", value)
+	}
+
+	r, ok := parseDecimal(s)
+	if !ok {
+		return nil, faults.ErrInvalidNumericFormat
+	}
+	return r, nil
+}
+
+// multipleOfRule accepts numbers divisible by the parameter, e.g.
+// `multipleof=100` or `multipleof=0.05`. Floats are taken at their
+// shortest decimal form, so 0.3 is a multiple of 0.1.
+func multipleOfRule(value any, param string) error {
+	val := reflect.ValueOf(value)
+	if isIntKind(val.Kind()) {
+		if divisor, err := strconv.ParseInt(param, 10, 64); err == nil && divisor != 0 {
+			if val.Int()%divisor != 0 {
+				return faults.ErrMustBeMultipleOf.Render(param)
+			}
+			return nil
+		}
+	}
+
+	divisor, ok := parseDecimal(param)
+	if !ok || divisor.Sign() == 0 {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	s, err := decimalString(value)
+	if err != nil {
+		return err
+	}
+
+	r, ok := parseDecimal(s)
+	if !ok {
+		return faults.ErrInvalidNumericFormat
+	}
+
+	if !r.Quo(r, divisor).IsInt() {
+		return faults.ErrMustBeMultipleOf.Render(param)
+	}
+	return nil
+}
+
+// scaleRule accepts numbers with at most the given number of decimal
+// places, not counting trailing zeros.
+func scaleRule(value any, param string) error {
+	limit, err := strconv.Atoi(param)
+	if err != nil || limit < 0 {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	_, scale, err := decimalDigits(value)
+	if err != nil {
+		return err
+	}
+
+	if scale > limit {
+		return faults.ErrScaleAboveMaximum.Render(limit)
+	}
+	return nil
+}
+
+// precisionRule accepts numbers with at most the given number of
+// significant digits in total, like a SQL NUMERIC column.
+func precisionRule(value any, param string) error {
+	limit, err := strconv.Atoi(param)
+	if err != nil || limit < 0 {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+
+	intDigits, scale, err := decimalDigits(value)
+	if err != nil {
+		return err
+	}
+
+	if intDigits+scale > limit {
+		return faults.ErrPrecisionAboveMaximum.Render(limit)
+	}
+	return nil
+}
+
+func positiveRule(value any, _ string) error {
+	c, err := compareNumber(value, "0")
+	if err != nil {
+		return err
+	}
+
+	if c <= 0 {
+		return faults.ErrMustBePositive
+	}
+	return nil
+}
+
+func negativeRule(value any, _ string) error {
+	c, err := compareNumber(value, "0")
+	if err != nil {
+		return err
+	}
+
+	if c >= 0 {
+		return faults.ErrMustBeNegative
+	}
+	return nil
+}
+
+func nonzeroRule(value any, _ string) error {
+	c, err := compareNumber(value, "0")
+	if err != nil {
+		return err
+	}
+
+	if c == 0 {
+		return faults.ErrMustBeNonzero
+	}
+	return nil
+}
+
+// finiteRule rejects NaN and infinite floats, including numeric strings
+// such as "Inf".
+func finiteRule(value any, _ string) error {
+	if v, ok := value.(typedef.Validatable); ok {
+		if err := v.Err(); err != nil {
+			return err
+		}
+	}
+
+	var f float64
+
+	val := reflect.ValueOf(value)
+	switch v := value.(type) {
+	case interface{ Float64() float64 }:
+		f = v.Float64()
+
+	default:
+		switch {
+		case isIntKind(val.Kind()) || isUintKind(val.Kind()):
+			return nil
+		case val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64:
+			f = val.Float()
+		case val.Kind() == reflect.String:
+			parsed, err := strconv.ParseFloat(val.String(), 64)
+			if err != nil && !errors.Is(err, strconv.ErrRange) {
+				return faults.ErrInvalidNumericFormat
+			}
+			f = parsed
+		default:
+			return faults.ErrUnsupportedDataType
+		}
+	}
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return faults.ErrMustBeFinite
+	}
+	return nil
+}
+
+// decimalString returns a number written in decimal: floats in their
+// shortest form, typedef numbers, json.Number and strings as written.
+func decimalString(value any) (string, error) {
+	if v, ok := value.(typedef.Validatable); ok {
+		if err := v.Err(); err != nil {
+			return "", err
+		}
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case isIntKind(val.Kind()):
+		return strconv.FormatInt(val.Int(), 10), nil
+
+	case isUintKind(val.Kind()):
+		return strconv.FormatUint(val.Uint(), 10), nil
+
+	case val.Kind() == reflect.Float32 || val.Kind() == reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", faults.ErrInvalidNumericFormat
+		}
+		return strconv.FormatFloat(f, 'f', -1, val.Type().Bits()), nil
+	}
+
+	switch v := value.(type) {
+	case big.Int:
+		return v.String(), nil
+	case *big.Int:
+		return v.String(), nil
+	}
+
+	if s, ok := asString(value); ok {
+		return s, nil
+	}
+	return fmt.Sprintf("%v", value), nil
+}
+
+// decimalDigits returns the number of digits of a number before the
+// decimal point and after it, ignoring leading zeros and trailing zeros
+// after the point.
+func decimalDigits(value any) (intDigits, scale int, err error) {
+	s, err := decimalString(value)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	s = strings.TrimLeft(s, "+-")
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		if exp, err = strconv.Atoi(s[i+1:]); err != nil {
+			return 0, 0, faults.ErrInvalidNumericFormat
+		}
+		s = s[:i]
+	}
+
+	intPart, frac, _ := strings.Cut(s, ".")
+	digits := intPart + frac
+	if !isDigits(digits) {
+		return 0, 0, faults.ErrInvalidNumericFormat
+	}
+
+	// point is the position of the decimal point within digits.
+	point := len(intPart) + exp
+	for len(digits) > point && len(digits) > 0 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+	}
+
+	zeros := 0
+	for zeros < len(digits) && zeros < point && digits[zeros] == '0' {
+		zeros++
+	}
+
+	return max(0, point-zeros), max(0, len(digits)-point), nil
+}
+
+// parseDecimal parses a number in the syntax of decimalRe.
+func parseDecimal(s string) (*big.Rat, bool) {
+	if !decimalRe.MatchString(s) {
+		return nil, false
+	}
+	return new(big.Rat).SetString(s)
+}
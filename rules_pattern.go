@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/godev90/validator/faults"
+)
+
+// regexCache holds up to maxRegexps expressions used by `regex=` rules,
+// compiled once and shared by all engines.
+var regexCache = boundedCache{max: maxRegexps}
+
+// RegisterPattern compiles expr and makes it available to tags as
+// `pattern=name`. An invalid expression is reported here rather than when
+// a value is validated.
+func (e *Engine) RegisterPattern(name, expr string) error {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return fmt.Errorf("validator: invalid pattern %q: %w", name, err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.patterns == nil {
+		e.patterns = make(map[string]*regexp.Regexp)
+	}
+	e.patterns[name] = re
+	e.invalidatePlans()
+	return nil
+}
+
+func (e *Engine) lookupPattern(name string) (*regexp.Regexp, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	re, ok := e.patterns[name]
+	return re, ok
+}
+
+// checkParam reports a parameter that can never work, so the tag is
+// rejected when its plan is compiled: a `regex=` expression that does not
+// compile always, and in strict mode a `pattern=` or `enum=` name never
+// registered.
+func (e *Engine) checkParam(rule tagRule, strict bool) error {
+	switch rule.name {
+	case "regex":
+		if _, err := compileRegex(rule.param); err != nil {
+			return &TagError{Pos: rule.pos, Reason: fmt.Sprintf("invalid regex %q: %v", rule.param, err)}
+		}
+	case "pattern":
+		if _, ok := e.lookupPattern(rule.param); !ok && strict {
+			return &TagError{Pos: rule.pos, Reason: fmt.Sprintf("unknown pattern %q", rule.param)}
+		}
+	case "enum", "enum_ci":
+		if _, ok := e.lookupEnum(rule.param); !ok && strict && rule.param != "" {
+			return &TagError{Pos: rule.pos, Reason: fmt.Sprintf("unknown enum %q", rule.param)}
+		}
+	}
+	return nil
+}
+
+// regexRule matches strings against the expression given as parameter.
+// Expressions containing commas have to be quoted, e.g. `regex='^\d{2,4}$'`.
+func regexRule(value any, param string) error {
+	re, err := compileRegex(param)
+	if err != nil {
+		return faults.ErrInvalidParameter.Render(param)
+	}
+	return matchPattern(re, value)
+}
+
+// patternRule matches strings against a pattern registered with
+// RegisterPattern.
+func patternRule(fl FieldLevel) error {
+	re, ok := fl.engineOrDefault().lookupPattern(fl.Param())
+	if !ok {
+		return faults.ErrInvalidParameter.Render(fl.Param())
+	}
+	return matchPattern(re, fl.Value())
+}
+
+func matchPattern(re *regexp.Regexp, value any) error {
+	s, ok := asString(value)
+	if !ok {
+		return faults.ErrUnsupportedDataType
+	}
+
+	if !re.MatchString(s) {
+		return faults.ErrMustMatchPattern
+	}
+	return nil
+}
+
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := regexCache.LoadOrStore(expr, re)
+	return actual.(*regexp.Regexp), nil
+}
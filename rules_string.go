@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/godev90/validator/faults"
+)
+
+func containsRule(value any, param string) error {
+	if s, ok := asString(value); !ok || !strings.Contains(s, param) {
+		return faults.ErrMustContain.Render(param)
+	}
+	return nil
+}
+
+func excludesRule(value any, param string) error {
+	if s, ok := asString(value); !ok || strings.Contains(s, param) {
+		return faults.ErrMustNotContain.Render(param)
+	}
+	return nil
+}
+
+func startsWithRule(value any, param string) error {
+	if s, ok := asString(value); !ok || !strings.HasPrefix(s, param) {
+		return faults.ErrMustStartWith.Render(param)
+	}
+	return nil
+}
+
+func endsWithRule(value any, param string) error {
+	if s, ok := asString(value); !ok || !strings.HasSuffix(s, param) {
+		return faults.ErrMustEndWith.Render(param)
+	}
+	return nil
+}
+
+// lowercaseRule accepts strings without upper or title case letters, in
+// any script.
+func lowercaseRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || strings.ToLower(s) != s {
+		return faults.ErrMustBeLowercase
+	}
+	return nil
+}
+
+func uppercaseRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || strings.ToUpper(s) != s {
+		return faults.ErrMustBeUppercase
+	}
+	return nil
+}
+
+func asciiRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || !allRunes(s, isASCII) {
+		return faults.ErrMustBeASCII
+	}
+	return nil
+}
+
+// printableRule accepts strings made of letters, marks, numbers,
+// punctuation, symbols and ASCII spaces, rejecting control characters.
+func printableRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || !allRunes(s, unicode.IsPrint) {
+		return faults.ErrMustBePrintable
+	}
+	return nil
+}
+
+// trimmedRule rejects strings with leading or trailing white space.
+func trimmedRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || strings.TrimSpace(s) != s {
+		return faults.ErrMustBeTrimmed
+	}
+	return nil
+}
+
+func noWhitespaceRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || strings.IndexFunc(s, unicode.IsSpace) >= 0 {
+		return faults.ErrMustNotContainWhitespace
+	}
+	return nil
+}
+
+// alphaUnicodeRule is the Unicode variant of alphabet: letters of any
+// script, e.g. "Zoë" or "Đặng".
+func alphaUnicodeRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || s == "" || !allRunes(s, unicode.IsLetter) {
+		return faults.ErrMustBeAlphabet
+	}
+	return nil
+}
+
+// alphanumUnicodeRule is the Unicode variant of alphanum.
+func alphanumUnicodeRule(value any, _ string) error {
+	isAlphanum := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsNumber(r)
+	}
+
+	if s, ok := asString(value); !ok || s == "" || !allRunes(s, isAlphanum) {
+		return faults.ErrMustBeAlphanum
+	}
+	return nil
+}
+
+// digitUnicodeRule is the Unicode variant of digit, accepting decimal
+// digits of any script, e.g. Arabic-Indic ones.
+func digitUnicodeRule(value any, _ string) error {
+	if s, ok := asString(value); !ok || s == "" || !allRunes(s, unicode.IsDigit) {
+		return faults.ErrMustBeDigit
+	}
+	return nil
+}
+
+func allRunes(s string, fn func(rune) bool) bool {
+	for _, r := range s {
+		if !fn(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCII(r rune) bool {
+	return r <= unicode.MaxASCII
+}
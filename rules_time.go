@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/godev90/validator/faults"
+	"github.com/godev90/validator/typedef"
+)
+
+// beforeRule accepts times before the parameter, a date, a datetime,
+// "now" or "today".
+func beforeRule(fl FieldLevel) error {
+	t, limit, err := timeAndParam(fl)
+	if err != nil {
+		return err
+	}
+
+	if !t.Before(limit) {
+		return faults.ErrMustBeBefore.Render(fl.Param())
+	}
+	return nil
+}
+
+// afterRule accepts times after the parameter, see beforeRule.
+func afterRule(fl FieldLevel) error {
+	t, limit, err := timeAndParam(fl)
+	if err != nil {
+		return err
+	}
+
+	if !t.After(limit) {
+		return faults.ErrMustBeAfter.Render(fl.Param())
+	}
+	return nil
+}
+
+func pastRule(fl FieldLevel) error {
+	t, err := timeOf(fl)
+	if err != nil {
+		return err
+	}
+
+	if !t.Before(time.Now()) {
+		return faults.ErrMustBeInPast
+	}
+	return nil
+}
+
+func futureRule(fl FieldLevel) error {
+	t, err := timeOf(fl)
+	if err != nil {
+		return err
+	}
+
+	if !t.After(time.Now()) {
+		return faults.ErrMustBeInFuture
+	}
+	return nil
+}
+
+// minAgeRule accepts birth dates of people at least the given number of
+// years old today.
+func minAgeRule(fl FieldLevel) error {
+	age, minAge, err := ageAndParam(fl)
+	if err != nil {
+		return err
+	}
+
+	if age < minAge {
+		return faults.ErrAgeBelowMinimum.Render(minAge)
+	}
+	return nil
+}
+
+// maxAgeRule accepts birth dates of people at most the given number of
+// years old today.
+func maxAgeRule(fl FieldLevel) error {
+	age, maxAge, err := ageAndParam(fl)
+	if err != nil {
+		return err
+	}
+
+	if age > maxAge {
+		return faults.ErrAgeAboveMaximum.Render(maxAge)
+	}
+	return nil
+}
+
+// withinRule accepts times at most the given duration away from now, in
+// either direction, e.g. `within=72h`.
+func withinRule(fl FieldLevel) error {
+	d, err := time.ParseDuration(fl.Param())
+	if err != nil || d < 0 {
+		return faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	t, err := timeOf(fl)
+	if err != nil {
+		return err
+	}
+
+	if diff := time.Since(t); diff > d || diff < -d {
+		return faults.ErrMustBeWithin.Render(fl.Param())
+	}
+	return nil
+}
+
+func timeAndParam(fl FieldLevel) (time.Time, time.Time, error) {
+	limit, ok := timeParam(fl)
+	if !ok {
+		return time.Time{}, time.Time{}, faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	t, err := timeOf(fl)
+	return t, limit, err
+}
+
+func ageAndParam(fl FieldLevel) (int, int, error) {
+	years, err := strconv.Atoi(fl.Param())
+	if err != nil || years < 0 {
+		return 0, 0, faults.ErrInvalidParameter.Render(fl.Param())
+	}
+
+	birth, err := timeOf(fl)
+	if err != nil {
+		return 0, 0, err
+	}
+	return age(birth, time.Now()), years, nil
+}
+
+// age returns the number of full years between birth and now, on the
+// calendar of the typedef timezone.
+func age(birth, now time.Time) int {
+	birth = birth.In(typedef.Timezone())
+	now = now.In(typedef.Timezone())
+
+	years := now.Year() - birth.Year()
+	if now.Month() < birth.Month() || now.Month() == birth.Month() && now.Day() < birth.Day() {
+		years--
+	}
+	return years
+}
+
+// timeOf returns the time held by the value: a time.Time, a type with a
+// Time method such as typedef.Date and typedef.Datetime, or a string in one
+// of the engine's date or datetime layouts, or RFC 3339.
+func timeOf(fl FieldLevel) (time.Time, error) {
+	value := fl.Value()
+	if v, ok := value.(typedef.Validatable); ok {
+		if err := v.Err(); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case timeValue:
+		return v.Time(), nil
+	}
+
+	s, ok := asString(value)
+	if !ok {
+		return time.Time{}, faults.ErrUnsupportedDataType
+	}
+
+	engine := fl.engineOrDefault()
+	t, ok := parseTime(engine.timeLayouts, s)
+	if !ok {
+		return time.Time{}, dateLayoutError(engine.dateLayouts)
+	}
+	return t, nil
+}
+
+// timeParam parses the parameter of a time rule: "now", "today" or a
+// date string.
+func timeParam(fl FieldLevel) (time.Time, bool) {
+	switch fl.Param() {
+	case "now":
+		return time.Now(), true
+	case "today":
+		y, m, d := time.Now().In(typedef.Timezone()).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, typedef.Timezone()), true
+	}
+	return parseTime(fl.engineOrDefault().timeLayouts, fl.Param())
+}
+
+func parseTime(layouts []string, s string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if t, err := typedef.ParseTime(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
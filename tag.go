@@ -0,0 +1,197 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// tagRule is a single rule of a tag, e.g. `min=3`. Pos is its byte
+	// offset in the tag.
+	tagRule struct {
+		name  string
+		param string
+		pos   int
+	}
+
+	// tagGroup holds rules separated by `|`, of which one has to pass.
+	// Most groups hold a single rule.
+	tagGroup []tagRule
+
+	// TagError reports a malformed rule tag, or an unknown rule in strict mode.
+	TagError struct {
+		Struct string
+		Field  string
+		Tag    string
+		Pos    int
+		Reason string
+	}
+)
+
+func (err *TagError) Error() string {
+	where := ""
+	if err.Field != "" {
+		where = fmt.Sprintf(" on %s.%s", err.Struct, err.Field)
+	}
+
+	return fmt.Sprintf("validator: invalid tag %q%s at position %d: %s", err.Tag, where, err.Pos, err.Reason)
+}
+
+func (g tagGroup) keyword(name string) bool {
+	return len(g) == 1 && g[0].name == name && g[0].param == ""
+}
+
+// parseTag splits a rule tag into groups. Rules are separated by commas
+// and a parameter follows the rule name after `=`:
+//
+//	required,minlen=3,email|digit,oneof=a|b|c,oneof='x,y'|email,contains=a\,b
+//
+// A `|` after a rule without parameter, or after a quoted parameter, starts
+// an alternative rule. Within an unquoted parameter `|` is literal, so
+// `oneof=a|b` keeps its meaning, and `\,` stands for a comma. Parameters
+// quoted with single quotes may hold commas and pipes, with `\'` standing
+// for a quote.
+func parseTag(tag string) ([]tagGroup, error) {
+	p := tagParser{tag: tag}
+	return p.parse()
+}
+
+type tagParser struct {
+	tag string
+	pos int
+}
+
+func (p *tagParser) parse() ([]tagGroup, error) {
+	if strings.TrimSpace(p.tag) == "" {
+		return nil, nil
+	}
+
+	var (
+		groups []tagGroup
+		group  tagGroup
+	)
+
+	for {
+		rule, err := p.rule()
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, rule)
+
+		p.skipSpaces()
+		if p.pos == len(p.tag) {
+			return append(groups, group), nil
+		}
+
+		switch p.tag[p.pos] {
+		case ',':
+			groups = append(groups, group)
+			group = nil
+		case '|':
+			// alternative of the same group
+		default:
+			return nil, p.errorf("unexpected %q", p.tag[p.pos])
+		}
+		p.pos++
+	}
+}
+
+func (p *tagParser) rule() (tagRule, error) {
+	p.skipSpaces()
+
+	rule := tagRule{pos: p.pos}
+	for p.pos < len(p.tag) && isRuleNameByte(p.tag[p.pos]) {
+		p.pos++
+	}
+	rule.name = p.tag[rule.pos:p.pos]
+
+	if rule.name == "" {
+		if p.pos == len(p.tag) {
+			return rule, p.errorf("expected rule name at end of tag")
+		}
+		return rule, p.errorf("expected rule name, found %q", p.tag[p.pos])
+	}
+
+	p.skipSpaces()
+	if p.pos == len(p.tag) || p.tag[p.pos] != '=' {
+		return rule, nil
+	}
+	p.pos++
+
+	p.skipSpaces()
+	if p.pos < len(p.tag) && p.tag[p.pos] == '\'' {
+		param, err := p.quoted()
+		rule.param = param
+		return rule, err
+	}
+
+	rule.param = p.unquoted()
+	return rule, nil
+}
+
+// quoted reads a parameter enclosed in single quotes.
+func (p *tagParser) quoted() (string, error) {
+	start := p.pos
+	p.pos++ // opening quote
+
+	var param strings.Builder
+	for p.pos < len(p.tag) {
+		c := p.tag[p.pos]
+
+		switch {
+		case c == '\\' && p.pos+1 < len(p.tag) && p.tag[p.pos+1] == '\'':
+			param.WriteByte('\'')
+			p.pos += 2
+		case c == '\'':
+			p.pos++
+			return param.String(), nil
+		default:
+			param.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	p.pos = start
+	return "", p.errorf("unterminated quoted parameter")
+}
+
+// unquoted reads a parameter up to the next unescaped comma.
+func (p *tagParser) unquoted() string {
+	var param strings.Builder
+	for p.pos < len(p.tag) {
+		c := p.tag[p.pos]
+
+		if c == ',' {
+			break
+		}
+
+		if c == '\\' && p.pos+1 < len(p.tag) && p.tag[p.pos+1] == ',' {
+			param.WriteByte(',')
+			p.pos += 2
+			continue
+		}
+
+		param.WriteByte(c)
+		p.pos++
+	}
+
+	return strings.TrimRight(param.String(), " ")
+}
+
+func (p *tagParser) skipSpaces() {
+	for p.pos < len(p.tag) && p.tag[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *tagParser) errorf(format string, args ...any) error {
+	return &TagError{
+		Tag:    p.tag,
+		Pos:    p.pos,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+func isRuleNameByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-' || c == '.'
+}
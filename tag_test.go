@@ -0,0 +1,110 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want []tagGroup
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"required", []tagGroup{
+			{{name: "required", pos: 0}},
+		}},
+		{"required,minlen=3", []tagGroup{
+			{{name: "required", pos: 0}},
+			{{name: "minlen", param: "3", pos: 9}},
+		}},
+		{" required , minlen = 3 ", []tagGroup{
+			{{name: "required", pos: 1}},
+			{{name: "minlen", param: "3", pos: 12}},
+		}},
+		{"email|digit", []tagGroup{
+			{{name: "email", pos: 0}, {name: "digit", pos: 6}},
+		}},
+		{"oneof=a|b|c", []tagGroup{
+			{{name: "oneof", param: "a|b|c", pos: 0}},
+		}},
+		{"oneof='x,y'|email", []tagGroup{
+			{{name: "oneof", param: "x,y", pos: 0}, {name: "email", pos: 12}},
+		}},
+		{"oneof='a|b',required", []tagGroup{
+			{{name: "oneof", param: "a|b", pos: 0}},
+			{{name: "required", pos: 12}},
+		}},
+		{`contains='it\'s'`, []tagGroup{
+			{{name: "contains", param: "it's", pos: 0}},
+		}},
+		{`contains=a\,b,required`, []tagGroup{
+			{{name: "contains", param: "a,b", pos: 0}},
+			{{name: "required", pos: 14}},
+		}},
+		{`contains=a\b`, []tagGroup{
+			{{name: "contains", param: `a\b`, pos: 0}},
+		}},
+		{"regex=^a=b$", []tagGroup{
+			{{name: "regex", param: "^a=b$", pos: 0}},
+		}},
+		{"contains=", []tagGroup{
+			{{name: "contains", pos: 0}},
+		}},
+		{"omitempty,dive,keys,required,endkeys,min=1", []tagGroup{
+			{{name: "omitempty", pos: 0}},
+			{{name: "dive", pos: 10}},
+			{{name: "keys", pos: 15}},
+			{{name: "required", pos: 20}},
+			{{name: "endkeys", pos: 29}},
+			{{name: "min", param: "1", pos: 37}},
+		}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseTag(tt.tag)
+		if err != nil {
+			t.Errorf("parseTag(%q): unexpected error: %v", tt.tag, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTag(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestParseTagErrors(t *testing.T) {
+	tests := []struct {
+		tag    string
+		pos    int
+		reason string
+	}{
+		{",required", 0, `expected rule name, found ','`},
+		{"required,", 9, "expected rule name at end of tag"},
+		{"required,,min=1", 9, `expected rule name, found ','`},
+		{"email|", 6, "expected rule name at end of tag"},
+		{"=3", 0, `expected rule name, found '='`},
+		{"min 3", 4, `unexpected '3'`},
+		{"oneof='a,b", 6, "unterminated quoted parameter"},
+		{"oneof='a'b", 9, `unexpected 'b'`},
+		{"required,len=3,oneof='x", 21, "unterminated quoted parameter"},
+	}
+
+	for _, tt := range tests {
+		_, err := parseTag(tt.tag)
+
+		var tagErr *TagError
+		if !errors.As(err, &tagErr) {
+			t.Errorf("parseTag(%q): error = %v, want *TagError", tt.tag, err)
+			continue
+		}
+		if tagErr.Pos != tt.pos || tagErr.Reason != tt.reason {
+			t.Errorf("parseTag(%q): error at %d %q, want at %d %q", tt.tag, tagErr.Pos, tagErr.Reason, tt.pos, tt.reason)
+		}
+		if tagErr.Tag != tt.tag {
+			t.Errorf("parseTag(%q): error tag = %q", tt.tag, tagErr.Tag)
+		}
+	}
+}
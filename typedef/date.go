@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
-	"strings"
 	"time"
 
 	"github.com/godev90/validator/faults"
@@ -29,38 +28,11 @@ func (d *Date) Set(val any) error {
 	switch v := val.(type) {
 	case time.Time:
 		d.t = v.In(localTime)
-		d.s = v.Format(dateLayout)
+		d.s = FormatTime(dateFormat, v)
 		return nil
 
 	case string:
-
-		layouts := []string{
-			dateLayout,
-			datetimeISOZLayout,
-			time.RFC3339,
-			datetimeLayout,
-		}
-
-		for _, layout := range layouts {
-			var t time.Time
-			var err error
-
-			if strings.HasSuffix(layout, "Z") || layout == time.RFC3339 {
-				t, err = time.Parse(layout, v)
-			} else {
-				t, err = time.ParseInLocation(layout, v, localTime)
-			}
-
-			if err == nil {
-				d.t = t.In(localTime)
-				d.s = t.Format(dateLayout)
-				return nil
-			}
-		}
-
-		d.err = faults.ErrInvalidDateFormat
-		d.t = time.Time{}
-		d.s = ""
+		d.setString(v, dateFormat, dateLayouts)
 
 	default:
 		d.err = faults.ErrInvalidDateFormat
@@ -71,6 +43,20 @@ func (d *Date) Set(val any) error {
 	return nil
 }
 
+// setString parses v with format, so that values written by String and
+// MarshalJSON read back unchanged, and then with layouts.
+func (d *Date) setString(v, format string, layouts []string) {
+	if t, ok := parseLayouts(v, format, layouts); ok {
+		d.t = t
+		d.s = FormatTime(dateFormat, t)
+		return
+	}
+
+	d.err = faults.ErrInvalidDateFormat
+	d.t = time.Time{}
+	d.s = ""
+}
+
 func (d Date) String() string {
 	return d.s
 }
@@ -95,10 +81,11 @@ func (d Date) Validate() error {
 	return d.err
 }
 
-// UnmarshalJSON parses date from JSON string
+// UnmarshalJSON parses date from JSON string, or from a JSON number with
+// the LayoutUnix or LayoutUnixMilli layouts
 func (d *Date) UnmarshalJSON(data []byte) error {
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
+	str, ok := unquoteJSON(data)
+	if !ok {
 		d.t = time.Time{}
 		d.s = ""
 		d.err = faults.ErrInvalidDateFormat
@@ -119,7 +106,7 @@ func (d Date) MarshalJSON() ([]byte, error) {
 	if !d.Valid() {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(d.t.Format(dateLayout))
+	return marshalTime(dateFormat, d.t)
 }
 
 // Value for sql.Valuer
@@ -139,13 +126,13 @@ func (d *Date) Scan(value any) error {
 		d.Set(v)
 
 	case []byte:
-		d.err = d.Set(string(v))
+		d.setString(string(v), dateLayout, sqlDateLayouts)
 
 	case sql.RawBytes:
-		d.err = d.Set(string([]byte(v)))
+		d.setString(string(v), dateLayout, sqlDateLayouts)
 
 	case string:
-		d.err = d.Set(v)
+		d.setString(v, dateLayout, sqlDateLayouts)
 
 	default:
 		d.err = faults.ErrInvalidDateFormat
@@ -165,7 +152,7 @@ func NewDate(value time.Time) Date {
 	value = value.In(localTime)
 	return Date{
 		t:   value,
-		s:   value.Format(dateLayout),
+		s:   FormatTime(dateFormat, value),
 		err: nil,
 	}
 }
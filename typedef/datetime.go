@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
-	"strings"
 	"time"
 
 	"github.com/godev90/validator/faults"
@@ -27,6 +26,12 @@ func SetTimezone(timezone string) {
 	localTime = loc
 }
 
+// Timezone returns the timezone set by SetTimezone, used to parse and
+// format dates and datetimes.
+func Timezone() *time.Location {
+	return localTime
+}
+
 type Datetime struct {
 	t   time.Time
 	s   string
@@ -39,37 +44,10 @@ func (d *Datetime) Set(val any) error {
 	switch v := val.(type) {
 	case time.Time:
 		d.t = v.In(localTime)
-		d.s = v.Format(datetimeLayout)
+		d.s = FormatTime(datetimeFormat, v)
 
 	case string:
-		layouts := []string{
-			datetimeLayout,
-			datetimeISOZLayout,
-			time.RFC3339,
-			dateLayout,
-		}
-
-		for _, layout := range layouts {
-			var t time.Time
-			var err error
-
-			if strings.HasSuffix(layout, "Z") || layout == time.RFC3339 {
-				t, err = time.Parse(layout, v)
-			} else {
-				t, err = time.ParseInLocation(layout, v, localTime)
-			}
-
-			if err == nil {
-				d.t = t.In(localTime)
-				d.s = t.Format(datetimeLayout)
-				return nil
-			}
-
-		}
-
-		d.err = faults.ErrInvalidDatetimeFormat
-		d.t = time.Time{}
-		d.s = ""
+		d.setString(v, datetimeFormat, datetimeLayouts)
 
 	default:
 		d.t = time.Time{}
@@ -80,6 +58,20 @@ func (d *Datetime) Set(val any) error {
 	return nil
 }
 
+// setString parses v with format, so that values written by String and
+// MarshalJSON read back unchanged, and then with layouts.
+func (d *Datetime) setString(v, format string, layouts []string) {
+	if t, ok := parseLayouts(v, format, layouts); ok {
+		d.t = t
+		d.s = FormatTime(datetimeFormat, t)
+		return
+	}
+
+	d.err = faults.ErrInvalidDatetimeFormat
+	d.t = time.Time{}
+	d.s = ""
+}
+
 func (d Datetime) String() string {
 	return d.s
 }
@@ -104,15 +96,17 @@ func (d Datetime) Validate() error {
 	return d.err
 }
 
-// UnmarshalJSON parses from JSON string
+// UnmarshalJSON parses from JSON string, or from a JSON number with the
+// LayoutUnix or LayoutUnixMilli layouts
 func (d *Datetime) UnmarshalJSON(data []byte) error {
-	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
-		d.err = faults.ErrInvalidDatetimeFormat
+	str, ok := unquoteJSON(data)
+	if !ok {
 		d.t = time.Time{}
 		d.s = ""
+		d.err = faults.ErrInvalidDatetimeFormat
 		return nil
 	}
+
 	_ = d.Set(str)
 	return nil
 }
@@ -127,7 +121,7 @@ func (d Datetime) MarshalJSON() ([]byte, error) {
 	if !d.Valid() {
 		return json.Marshal(nil)
 	}
-	return json.Marshal(d.t.Format(datetimeLayout))
+	return marshalTime(datetimeFormat, d.t)
 }
 
 // Value for sql.Valuer
@@ -147,13 +141,13 @@ func (d *Datetime) Scan(value any) error {
 		d.Set(v)
 
 	case []byte:
-		d.err = d.Set(string(v))
+		d.setString(string(v), datetimeLayout, sqlDatetimeLayouts)
 
 	case sql.RawBytes:
-		d.err = d.Set(string([]byte(v)))
+		d.setString(string(v), datetimeLayout, sqlDatetimeLayouts)
 
 	case string:
-		d.err = d.Set(v)
+		d.setString(v, datetimeLayout, sqlDatetimeLayouts)
 
 	default:
 		d.err = faults.ErrInvalidDatetimeFormat
@@ -173,7 +167,7 @@ func NewDatetime(value time.Time) Datetime {
 	value = value.In(localTime)
 	return Datetime{
 		t:   value,
-		s:   value.Format(datetimeLayout),
+		s:   FormatTime(datetimeFormat, value),
 		err: nil,
 	}
 }
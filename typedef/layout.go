@@ -0,0 +1,158 @@
+package typedef
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts for Unix timestamps, in seconds or milliseconds, usable wherever
+// a time layout is expected.
+const (
+	LayoutUnix      = "unix"
+	LayoutUnixMilli = "unixmilli"
+)
+
+var (
+	// layoutNames are the names accepted in place of the standard
+	// layouts, so they can be written in struct tags without quoting.
+	layoutNames = map[string]string{
+		"ansic":       time.ANSIC,
+		"rfc822":      time.RFC822,
+		"rfc822z":     time.RFC822Z,
+		"rfc850":      time.RFC850,
+		"rfc1123":     time.RFC1123,
+		"rfc1123z":    time.RFC1123Z,
+		"rfc3339":     time.RFC3339,
+		"rfc3339nano": time.RFC3339Nano,
+	}
+
+	dateLayouts     = []string{dateLayout, datetimeISOZLayout, time.RFC3339, datetimeLayout}
+	datetimeLayouts = []string{datetimeLayout, datetimeISOZLayout, time.RFC3339, dateLayout}
+
+	// sqlDateLayouts and sqlDatetimeLayouts are the layouts Scan accepts,
+	// whatever the layouts set for input, as SQL values are written by
+	// Value in fixed layouts.
+	sqlDateLayouts     = []string{dateLayout, datetimeISOZLayout, time.RFC3339, datetimeLayout}
+	sqlDatetimeLayouts = []string{datetimeLayout, datetimeISOZLayout, time.RFC3339, dateLayout}
+
+	dateFormat     = dateLayout
+	datetimeFormat = datetimeLayout
+)
+
+// SetDateLayouts sets the layouts Date accepts when parsing strings, tried
+// in order after the format set by SetDateFormat. Like SetTimezone, it is
+// meant to be called at startup. Scan keeps the SQL layouts.
+func SetDateLayouts(layouts ...string) {
+	dateLayouts = layouts
+}
+
+// SetDatetimeLayouts sets the layouts Datetime accepts when parsing
+// strings, tried in order after the format set by SetDatetimeFormat.
+func SetDatetimeLayouts(layouts ...string) {
+	datetimeLayouts = layouts
+}
+
+// SetDateFormat sets the layout of Date in String and MarshalJSON. SQL
+// values keep the yyyy-mm-dd layout.
+func SetDateFormat(layout string) {
+	dateFormat = layout
+}
+
+// SetDatetimeFormat sets the layout of Datetime in String and
+// MarshalJSON. SQL values keep the yyyy-mm-dd hh:mm:ss layout.
+func SetDatetimeFormat(layout string) {
+	datetimeFormat = layout
+}
+
+// ParseTime parses value with layout in the timezone set by SetTimezone.
+// Besides Go layouts, layout can be LayoutUnix, LayoutUnixMilli or the
+// lower-case name of a standard layout such as "rfc1123".
+func ParseTime(layout, value string) (time.Time, error) {
+	switch layout = resolveLayout(layout); layout {
+	case LayoutUnix, LayoutUnixMilli:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if layout == LayoutUnix {
+			return time.Unix(n, 0).In(localTime), nil
+		}
+		return time.UnixMilli(n).In(localTime), nil
+
+	default:
+		// A literal Z, as in "2006-01-02T15:04:05Z", marks UTC.
+		if strings.HasSuffix(layout, "Z") || layout == time.RFC3339 {
+			t, err := time.Parse(layout, value)
+			return t.In(localTime), err
+		}
+		return time.ParseInLocation(layout, value, localTime)
+	}
+}
+
+// FormatTime formats t with layout, accepting the same layouts as
+// ParseTime.
+func FormatTime(layout string, t time.Time) string {
+	switch layout = resolveLayout(layout); layout {
+	case LayoutUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	case LayoutUnixMilli:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	default:
+		return t.In(localTime).Format(layout)
+	}
+}
+
+// IsUnixLayout reports whether layout formats times as numbers.
+func IsUnixLayout(layout string) bool {
+	return layout == LayoutUnix || layout == LayoutUnixMilli
+}
+
+func resolveLayout(layout string) string {
+	if std, ok := layoutNames[layout]; ok {
+		return std
+	}
+	return layout
+}
+
+// parseLayouts parses value with format, or else with the first of layouts
+// that matches.
+func parseLayouts(value, format string, layouts []string) (time.Time, bool) {
+	if t, err := ParseTime(format, value); err == nil {
+		return t.In(localTime), true
+	}
+
+	for _, layout := range layouts {
+		if t, err := ParseTime(layout, value); err == nil {
+			return t.In(localTime), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// unquoteJSON returns the content of a JSON string, or the text of a JSON
+// number, which holds a Unix timestamp.
+func unquoteJSON(data []byte) (string, bool) {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		return str, true
+	}
+
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err == nil {
+		return num.String(), true
+	}
+	return "", false
+}
+
+// marshalTime serializes t as a JSON string, or as a number for the Unix
+// layouts.
+func marshalTime(layout string, t time.Time) ([]byte, error) {
+	s := FormatTime(layout, t)
+	if IsUnixLayout(layout) {
+		return []byte(s), nil
+	}
+	return json.Marshal(s)
+}
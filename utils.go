@@ -2,8 +2,48 @@ package validator
 
 import (
 	"reflect"
+	"time"
+
+	"github.com/godev90/validator/typedef"
+)
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	validatableType = reflect.TypeOf((*typedef.Validatable)(nil)).Elem()
 )
 
 func isZero(val reflect.Value) bool {
 	return reflect.DeepEqual(val.Interface(), reflect.Zero(val.Type()).Interface())
 }
+
+// isNestedStruct reports whether values of typ are structs whose fields
+// should be validated recursively. Value types such as time.Time and the
+// typedef wrappers are treated as leaves.
+func isNestedStruct(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ == timeType {
+		return false
+	}
+
+	if typ.Implements(validatableType) || reflect.PointerTo(typ).Implements(validatableType) {
+		return false
+	}
+
+	return true
+}
+
+// asString returns the value of strings, including named string types.
+func asString(value any) (string, bool) {
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.String {
+		return val.String(), true
+	}
+	return "", false
+}
@@ -1,137 +1,209 @@
 package validator
 
-import (
-	"reflect"
-	"strings"
-	"sync"
-
-	"github.com/godev90/validator/faults"
-)
+import "context"
 
 type (
 	RuleFunc func(value any, param string) error
 
+	// RuleFuncCtx is a rule receiving the context given to
+	// ValidateStructCtx, e.g. to read the tenant of the request.
+	RuleFuncCtx func(ctx context.Context, value any, param string) error
+
+	// FieldRuleFunc is a rule that can inspect the struct around the
+	// value, e.g. to compare it with another field.
+	FieldRuleFunc func(fl FieldLevel) error
+
 	Validator interface {
 		Validate() error
 	}
+
+	// Enum is implemented by types that know their valid values, checked
+	// by the enum rule when no enum name is given.
+	Enum interface {
+		IsValid() bool
+	}
 )
 
 var (
-	validators = make(map[string]RuleFunc)
-	mu         sync.RWMutex
+	builtinRules = make(map[string]ruleEntry)
+
+	// std is the engine behind the package-level functions.
+	std *Engine
+
+	// presenceRules decide whether a value must be present at all, so
+	// unlike other rules they also run on nil pointers.
+	presenceRules = map[string]bool{
+		"required":         true,
+		"required_if":      true,
+		"required_unless":  true,
+		"required_with":    true,
+		"required_without": true,
+		"excluded_if":      true,
+	}
 )
 
 func init() {
-	RegisterValidator("required", requiredRule)
-	RegisterValidator("minlen", minlenRule)
-	RegisterValidator("maxlen", maxlenRule)
-	RegisterValidator("email", emailRule)
-	RegisterValidator("digit", digitRule)
-	RegisterValidator("alphabet", alphabetRule)
-	RegisterValidator("alphanum", alphanumRule)
-	RegisterValidator("min", minRule)
-	RegisterValidator("max", maxRule)
-	RegisterValidator("date", dateRule)
-	RegisterValidator("datetime", datetimeRule)
-	RegisterValidator("name", nameRule)
-	RegisterValidator("text", textRule)
-	RegisterValidator("oneof", oneOfRule)
+	registerBuiltin("required", requiredRule)
+	registerBuiltin("minlen", minlenRule)
+	registerBuiltin("maxlen", maxlenRule)
+	registerBuiltin("len", lenRule)
+	registerBuiltin("mingraphemes", minGraphemesRule)
+	registerBuiltin("maxgraphemes", maxGraphemesRule)
+	registerBuiltin("graphemes", graphemesRule)
+	registerBuiltin("email", emailRule)
+	registerBuiltin("digit", digitRule)
+	registerBuiltin("alphabet", alphabetRule)
+	registerBuiltin("alphanum", alphanumRule)
+	registerBuiltin("min", minRule)
+	registerBuiltin("max", maxRule)
+	registerBuiltin("gt", gtRule)
+	registerBuiltin("gte", minRule)
+	registerBuiltin("lt", ltRule)
+	registerBuiltin("lte", maxRule)
+	registerBuiltin("eq", eqRule)
+	registerBuiltin("ne", neRule)
+	registerBuiltin("between", betweenRule)
+	registerBuiltin("multipleof", multipleOfRule)
+	registerBuiltin("scale", scaleRule)
+	registerBuiltin("precision", precisionRule)
+	registerBuiltin("positive", positiveRule)
+	registerBuiltin("negative", negativeRule)
+	registerBuiltin("nonzero", nonzeroRule)
+	registerBuiltin("finite", finiteRule)
+	registerBuiltinField("enum", enumRule)
+	registerBuiltinField("enum_ci", enumCIRule)
+	registerBuiltinField("date", dateRule)
+	registerBuiltinField("datetime", datetimeRule)
+	registerBuiltin("name", nameRule)
+	registerBuiltin("text", textRule)
+	registerBuiltin("oneof", oneOfRule)
+	registerBuiltin("url", urlRule)
+	registerBuiltin("uri", uriRule)
+	registerBuiltin("hostname", hostnameRule)
+	registerBuiltin("ip", ipRule)
+	registerBuiltin("ipv4", ipv4Rule)
+	registerBuiltin("ipv6", ipv6Rule)
+	registerBuiltin("cidr", cidrRule)
+	registerBuiltin("mac", macRule)
+	registerBuiltin("port", portRule)
+	registerBuiltin("uuid", uuidRule)
+	registerBuiltin("ulid", ulidRule)
+	registerBuiltin("regex", regexRule)
+	registerBuiltinField("pattern", patternRule)
+	registerBuiltin("nik", nikRule)
+	registerBuiltin("npwp", npwpRule)
+	registerBuiltin("phone_id", phoneIDRule)
+	registerBuiltin("postcode_id", postcodeIDRule)
+	registerBuiltin("bank_account", bankAccountRule)
+	registerBuiltin("plate_id", plateIDRule)
+	registerBuiltin("credit_card", creditCardRule)
+	registerBuiltin("iban", ibanRule)
+	registerBuiltin("isbn", isbnRule)
+	registerBuiltin("isbn10", isbn10Rule)
+	registerBuiltin("isbn13", isbn13Rule)
+	registerBuiltin("ean13", ean13Rule)
+	registerBuiltin("upc", upcRule)
+	registerBuiltin("bic", bicRule)
+	registerBuiltin("contains", containsRule)
+	registerBuiltin("excludes", excludesRule)
+	registerBuiltin("startswith", startsWithRule)
+	registerBuiltin("endswith", endsWithRule)
+	registerBuiltin("lowercase", lowercaseRule)
+	registerBuiltin("uppercase", uppercaseRule)
+	registerBuiltin("ascii", asciiRule)
+	registerBuiltin("printable", printableRule)
+	registerBuiltin("trimmed", trimmedRule)
+	registerBuiltin("no_whitespace", noWhitespaceRule)
+	registerBuiltin("alpha_unicode", alphaUnicodeRule)
+	registerBuiltin("alphanum_unicode", alphanumUnicodeRule)
+	registerBuiltin("digit_unicode", digitUnicodeRule)
+	registerBuiltinField("before", beforeRule)
+	registerBuiltinField("after", afterRule)
+	registerBuiltinField("past", pastRule)
+	registerBuiltinField("future", futureRule)
+	registerBuiltinField("minage", minAgeRule)
+	registerBuiltinField("maxage", maxAgeRule)
+	registerBuiltinField("within", withinRule)
+	registerBuiltinField("eqfield", eqFieldRule)
+	registerBuiltinField("nefield", neFieldRule)
+	registerBuiltinField("gtfield", gtFieldRule)
+	registerBuiltinField("gtefield", gteFieldRule)
+	registerBuiltinField("ltfield", ltFieldRule)
+	registerBuiltinField("ltefield", lteFieldRule)
+	registerBuiltinField("required_if", requiredIfRule)
+	registerBuiltinField("required_unless", requiredUnlessRule)
+	registerBuiltinField("required_with", requiredWithRule)
+	registerBuiltinField("required_without", requiredWithoutRule)
+	registerBuiltinField("excluded_if", excludedIfRule)
+
+	std = New()
+}
+
+func registerBuiltin(name string, fn RuleFunc) {
+	builtinRules[name] = valueRuleEntry(fn)
+}
+
+func registerBuiltinField(name string, fn FieldRuleFunc) {
+	builtinRules[name] = fieldRuleEntry(fn)
+}
+
+// Default returns the engine used by the package-level functions.
+func Default() *Engine {
+	return std
 }
 
 func RegisterValidator(name string, fn RuleFunc) {
-	mu.Lock()
-	defer mu.Unlock()
-	validators[name] = fn
+	std.RegisterValidator(name, fn)
+}
+
+func RegisterValidatorCtx(name string, fn RuleFuncCtx) {
+	std.RegisterValidatorCtx(name, fn)
+}
+
+func RegisterFieldValidator(name string, fn FieldRuleFunc) {
+	std.RegisterFieldValidator(name, fn)
+}
+
+func RegisterPattern(name, expr string) error {
+	return std.RegisterPattern(name, expr)
 }
 
 func GetValidator(name string) (RuleFunc, bool) {
-	mu.RLock()
-	defer mu.RUnlock()
-	fn, ok := validators[name]
-	return fn, ok
-}
-
-func ruleContains(rules []string, target string) bool {
-	for _, rule := range rules {
-		rule = strings.TrimSpace(rule)
-		if strings.HasPrefix(rule, target+"=") || rule == target {
-			return true
-		}
-	}
-	return false
+	return std.GetValidator(name)
+}
+
+func SetStrict(strict bool) {
+	std.SetStrict(strict)
+}
+
+func CheckStruct(v any) error {
+	return std.CheckStruct(v)
 }
 
 func ValidateStruct(dest any) error {
-	val := reflect.ValueOf(dest)
-	if val.Kind() == reflect.Ptr {
-		val = val.Elem()
-	}
-	typ := val.Type()
-
-	errors := make(faults.Errors)
-
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Field(i)
-		structField := typ.Field(i)
-
-		if structField.PkgPath != "" {
-			continue // unexported
-		}
-
-		ruleTag := structField.Tag.Get("validation")
-		rules := strings.Split(ruleTag, ",")
-
-		fieldName := structField.Tag.Get("json")
-		if fieldName == "" || fieldName == "-" {
-			fieldName = structField.Name
-		} else {
-			fieldName = strings.Split(fieldName, ",")[0]
-		}
-
-		fieldValue := field
-		isPtr := field.Kind() == reflect.Ptr
-
-		if isPtr && field.IsNil() {
-			if ruleContains(rules, "required") {
-				if fn, ok := GetValidator("required"); ok {
-					if err := fn(nil, ""); err != nil {
-						errors[fieldName] = err
-					}
-				}
-			}
-			continue
-		}
-
-		if isPtr {
-			fieldValue = field.Elem()
-		}
-
-		for _, rule := range rules {
-			if _, exists := errors[fieldName]; exists {
-				break // stop if required or any previous rule failed
-			}
-
-			rule = strings.TrimSpace(rule)
-			parts := strings.SplitN(rule, "=", 2)
-			name := parts[0]
-			param := ""
-			if len(parts) == 2 {
-				param = parts[1]
-			}
-
-			fn, ok := GetValidator(name)
-			if !ok {
-				continue // unregistered validator
-			}
-
-			if err := fn(fieldValue.Interface(), param); err != nil {
-				errors[fieldName] = err
-			}
-		}
-	}
+	return std.ValidateStruct(dest)
+}
 
-	if len(errors) > 0 {
-		return errors
-	}
-	return nil
+func ValidateStructCtx(ctx context.Context, dest any) error {
+	return std.ValidateStructCtx(ctx, dest)
+}
+
+func ValidateFields(dest any) error {
+	return std.ValidateFields(dest)
+}
+
+func ValidateFieldsCtx(ctx context.Context, dest any) error {
+	return std.ValidateFieldsCtx(ctx, dest)
+}
+
+func ValidateVar(value any, rules string) error {
+	return std.ValidateVar(value, rules)
+}
+
+func ValidateVarCtx(ctx context.Context, value any, rules string) error {
+	return std.ValidateVarCtx(ctx, value, rules)
+}
+
+func ValidateVarWithValue(value, other any, rules string) error {
+	return std.ValidateVarWithValue(value, other, rules)
 }
@@ -0,0 +1,326 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/godev90/validator/faults"
+)
+
+// walker carries the state of a single ValidateStruct call while it
+// descends into nested structs.
+type walker struct {
+	engine *Engine
+	ctx    context.Context
+	top    reflect.Value
+
+	// done is the context's Done channel. err is set once the walk
+	// was aborted, because of the context or a malformed tag.
+	done <-chan struct{}
+	err  error
+
+	// visiting holds the structs reached through pointers on the current
+	// path, so self-referential pointers do not recurse forever.
+	visiting map[visitKey]struct{}
+}
+
+// visitKey identifies a struct reached through a pointer. The type is part
+// of the key because a struct and its first field share an address.
+type visitKey struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+func newWalker(ctx context.Context, engine *Engine) walker {
+	return walker{
+		engine: engine,
+		ctx:    ctx,
+		done:   ctx.Done(),
+	}
+}
+
+// aborted reports whether the walk was aborted, recording the context's
+// error once it is done.
+func (w *walker) aborted() bool {
+	if w.err != nil {
+		return true
+	}
+
+	select {
+	case <-w.done:
+		w.err = w.ctx.Err()
+		return true
+	default:
+		return false
+	}
+}
+
+// walkStruct validates the fields of val and then calls its Validate
+// method, if any. The hook is skipped for embedded structs whose Validate
+// method is promoted to, or overridden by, the outer struct. The field
+// holding val, if any, prefixes the namespaces of its fields.
+func (w *walker) walkStruct(val reflect.Value, owner *FieldLevel, errors *faults.Errors, hook bool) {
+	if w.aborted() {
+		return
+	}
+
+	plan := w.engine.structPlanFor(val.Type())
+	if plan.err != nil {
+		w.err = plan.err
+		return
+	}
+
+	for i := range plan.fields {
+		fp := &plan.fields[i]
+		field := val.Field(fp.index)
+
+		if fp.embedded {
+			if field.Kind() == reflect.Ptr {
+				if field.IsNil() || !w.enter(field) {
+					continue // nil or cycle
+				}
+				w.walkStruct(field.Elem(), owner, errors, !plan.hook)
+				w.leave(field)
+				continue
+			}
+			w.walkStruct(field, owner, errors, !plan.hook)
+			continue
+		}
+
+		fl := FieldLevel{
+			engine:          w.engine,
+			ctx:             w.ctx,
+			parent:          val,
+			top:             w.top,
+			name:            fp.name,
+			goName:          fp.goName,
+			namespace:       fp.name,
+			structNamespace: fp.goName,
+		}
+
+		if owner != nil {
+			fl.namespace = owner.namespace + "." + fp.name
+			fl.structNamespace = owner.structNamespace + "." + fp.goName
+		}
+
+		w.validateField(field, &fp.value, fl, errors)
+	}
+
+	if hook && plan.hook && !w.aborted() {
+		structHook(val, errors)
+	}
+}
+
+// validateField runs the plan against field and stores the outcome under
+// the field name of fl. Rules following a `dive` keyword are applied to
+// every element of a slice, array or map, whose errors are keyed as
+// name[index].
+func (w *walker) validateField(field reflect.Value, plan *valuePlan, fl FieldLevel, errors *faults.Errors) {
+	key := fl.name
+
+	if err := w.validateValue(field, plan, fl); err != nil {
+		addError(errors, key, err)
+		return
+	}
+
+	if !plan.dive {
+		return
+	}
+
+	for field.Kind() == reflect.Ptr || field.Kind() == reflect.Interface {
+		if field.IsNil() {
+			return
+		}
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < field.Len() && !w.aborted(); i++ {
+			w.validateField(field.Index(i), plan.elem, fl.index(i), errors)
+		}
+
+	case reflect.Map:
+		iter := field.MapRange()
+		for iter.Next() && !w.aborted() {
+			elem := fl.index(iter.Key().Interface())
+
+			if plan.keys != nil {
+				if err := w.validateValue(iter.Key(), plan.keys, elem); err != nil {
+					addError(errors, elem.name, err)
+					continue
+				}
+			}
+
+			w.validateField(iter.Value(), plan.elem, elem, errors)
+		}
+
+	default:
+		fl.field = field
+		addError(errors, key, fieldError("dive", fl, faults.ErrUnsupportedDataType))
+	}
+}
+
+func (w *walker) validateValue(field reflect.Value, plan *valuePlan, fl FieldLevel) error {
+	if field.Kind() == reflect.Interface && !field.IsNil() {
+		field = field.Elem() // e.g. elements of []any
+	}
+
+	fieldValue := field
+	isPtr := field.Kind() == reflect.Ptr
+	failed := failures{collectAll: w.engine.collectAll}
+
+	if (isPtr || field.Kind() == reflect.Interface) && field.IsNil() {
+		fl.field = field
+		w.runRules(plan.presence, fl, &failed)
+		return failed.err()
+	}
+
+	if isPtr {
+		fieldValue = field.Elem()
+	}
+	fl.field = fieldValue
+
+	traits := plan.traits
+	if plan.dynamic {
+		traits = traitsOf(fieldValue.Type())
+	}
+
+	if traits.validatable {
+		if err := parseError(fieldValue); err != nil {
+			failed.add(fieldError("parse", fl, w.engine.translate(err)))
+			return failed.err()
+		}
+	}
+
+	if len(plan.rules) > 0 {
+		fl.value = fieldValue.Interface()
+
+		if stop := w.runRules(plan.rules, fl, &failed); stop {
+			return failed.err()
+		}
+	}
+
+	if !traits.nested {
+		if traits.validator {
+			if err := callValidate(fieldValue); err != nil {
+				failed.add(fieldError("validate", fl, w.engine.translate(err)))
+			}
+		}
+		return failed.err()
+	}
+
+	if !failed.empty() {
+		return failed.err()
+	}
+
+	if isPtr {
+		if !w.enter(field) {
+			return nil // cycle
+		}
+		defer w.leave(field)
+	}
+
+	var errors faults.Errors
+	w.walkStruct(fieldValue, &fl, &errors, true)
+
+	if len(errors) > 0 {
+		return errors
+	}
+	return nil
+}
+
+// enter marks the struct ptr points to as being on the current path, and
+// reports false if it already was.
+func (w *walker) enter(ptr reflect.Value) bool {
+	key := visitKey{typ: ptr.Type(), addr: ptr.Pointer()}
+	if _, seen := w.visiting[key]; seen {
+		return false
+	}
+
+	if w.visiting == nil {
+		w.visiting = make(map[visitKey]struct{})
+	}
+	w.visiting[key] = struct{}{}
+	return true
+}
+
+func (w *walker) leave(ptr reflect.Value) {
+	delete(w.visiting, visitKey{typ: ptr.Type(), addr: ptr.Pointer()})
+}
+
+// runRules applies rules in order and reports whether validation of the
+// value should stop. Failing rules are recorded in failed, which keeps
+// going only when collecting all errors, and even then not past a failed
+// presence rule, since the remaining rules would only repeat that the
+// value is missing.
+func (w *walker) runRules(rules []compiledRule, fl FieldLevel, failed *failures) bool {
+	for i := range rules {
+		rule := &rules[i]
+
+		if err := rule.check(fl); err != nil {
+			fl.param = rule.param
+			failed.add(fieldError(rule.name, fl, w.engine.translate(err)))
+
+			if !failed.collectAll || rule.presence {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// failures accumulates the rule errors of a single value.
+type failures struct {
+	collectAll bool
+	list       faults.ErrorList
+}
+
+func (f *failures) add(err error) {
+	f.list = append(f.list, err)
+}
+
+func (f *failures) empty() bool {
+	return len(f.list) == 0
+}
+
+// err returns the first failure, or all of them when collecting all
+// errors.
+func (f *failures) err() error {
+	switch {
+	case len(f.list) == 0:
+		return nil
+	case f.collectAll:
+		return f.list
+	default:
+		return f.list[0]
+	}
+}
+
+// fieldError wraps the error of a rule with the details of the field it
+// failed on.
+func fieldError(rule string, fl FieldLevel, err error) error {
+	value := fl.value
+	if value == nil && fl.field.IsValid() && fl.field.Kind() != reflect.Ptr && fl.field.CanInterface() {
+		value = fl.field.Interface()
+	}
+
+	return faults.FieldError{
+		Rule:            rule,
+		Param:           fl.param,
+		Namespace:       fl.namespace,
+		StructNamespace: fl.structNamespace,
+		Field:           fl.name,
+		StructField:     fl.goName,
+		Kind:            fl.field.Kind(),
+		Value:           value,
+		Err:             err,
+	}
+}
+
+func addError(errors *faults.Errors, key string, err error) {
+	if *errors == nil {
+		*errors = make(faults.Errors)
+	}
+	(*errors)[key] = err
+}